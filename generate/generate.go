@@ -0,0 +1,256 @@
+// Package generate writes the Terraform configuration of an existing realm, along with the import blocks that bring
+// its objects under the management of Terraform.
+package generate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"github.com/keycloak/terraform-provider-keycloak/provider"
+)
+
+type Options struct {
+	Realm     string
+	OutputDir string
+	// ResourceTypes restricts the generated resources to the given types, all types are generated when empty
+	ResourceTypes []string
+	// NamePattern restricts the generated objects to the ones whose name matches, along with the objects they hold
+	NamePattern    *regexp.Regexp
+	IncludeBuiltIn bool
+}
+
+// providerFlags maps the flags of the generate subcommand to the provider settings. Settings that are not given on
+// the command line are read from the same environment variables as the provider.
+var providerFlags = map[string]string{
+	"url":                      "url",
+	"base-path":                "base_path",
+	"client-id":                "client_id",
+	"client-secret":            "client_secret",
+	"username":                 "username",
+	"password":                 "password",
+	"auth-realm":               "realm",
+	"root-ca-certificate":      "root_ca_certificate",
+	"tls-insecure-skip-verify": "tls_insecure_skip_verify",
+	"red-hat-sso":              "red_hat_sso",
+}
+
+// Run runs the generate subcommand with its command line arguments
+func Run(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+
+	realm := flags.String("realm", "", "name of the realm to generate the configuration of")
+	outputDir := flags.String("output", ".", "directory the .tf files are written to")
+	resourceTypes := flags.String("types", "", "comma separated list of resource types to generate, such as keycloak_openid_client or openid_client")
+	namePattern := flags.String("name", "", "regular expression the names of the generated objects must match")
+	includeBuiltIn := flags.Bool("include-builtin", false, "also generate the objects that Keycloak creates with every realm")
+
+	flags.String("url", "", "URL of the Keycloak instance, defaults to KEYCLOAK_URL")
+	flags.String("base-path", "", "base path of the Keycloak REST API, defaults to KEYCLOAK_BASE_PATH")
+	flags.String("client-id", "", "client used to log in, defaults to KEYCLOAK_CLIENT_ID")
+	flags.String("client-secret", "", "secret of the client used to log in, defaults to KEYCLOAK_CLIENT_SECRET")
+	flags.String("username", "", "username used to log in with the password grant, defaults to KEYCLOAK_USER")
+	flags.String("password", "", "password used to log in with the password grant, defaults to KEYCLOAK_PASSWORD")
+	flags.String("auth-realm", "", "realm used to log in, defaults to KEYCLOAK_REALM or master")
+	flags.String("root-ca-certificate", "", "CA certificate of the Keycloak instance")
+	flags.Bool("tls-insecure-skip-verify", false, "skip the verification of the Keycloak instance certificate")
+	flags.Bool("red-hat-sso", false, "treat the Keycloak instance as a Red Hat SSO server")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *realm == "" {
+		return fmt.Errorf("the -realm flag is required")
+	}
+
+	options := &Options{
+		Realm:          *realm,
+		OutputDir:      *outputDir,
+		IncludeBuiltIn: *includeBuiltIn,
+	}
+
+	if *resourceTypes != "" {
+		for _, resourceType := range strings.Split(*resourceTypes, ",") {
+			resourceType = strings.TrimSpace(resourceType)
+			if !strings.HasPrefix(resourceType, "keycloak_") {
+				resourceType = "keycloak_" + resourceType
+			}
+
+			options.ResourceTypes = append(options.ResourceTypes, resourceType)
+		}
+	}
+
+	if *namePattern != "" {
+		pattern, err := regexp.Compile(*namePattern)
+		if err != nil {
+			return fmt.Errorf("invalid -name pattern: %v", err)
+		}
+
+		options.NamePattern = pattern
+	}
+
+	keycloakProvider := provider.KeycloakProvider(nil)
+
+	config := map[string]interface{}{}
+	flags.Visit(func(f *flag.Flag) {
+		if setting, ok := providerFlags[f.Name]; ok {
+			config[setting] = f.Value.(flag.Getter).Get()
+		}
+	})
+
+	diagnostics := keycloakProvider.Configure(ctx, terraform.NewResourceConfigRaw(config))
+	if diagnostics.HasError() {
+		return fmt.Errorf("%s: %s", diagnostics[0].Summary, diagnostics[0].Detail)
+	}
+
+	warnings, err := Generate(ctx, keycloakProvider, options)
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+
+	return err
+}
+
+// Generate writes a .tf file per resource type for the objects of a realm, using a configured provider. It returns
+// the objects that were skipped as warnings.
+func Generate(ctx context.Context, keycloakProvider *schema.Provider, options *Options) ([]string, error) {
+	keycloakClient := keycloakProvider.Meta().(*keycloak.KeycloakClient)
+
+	w := &walker{
+		keycloakClient: keycloakClient,
+		realm:          options.Realm,
+		includeBuiltIn: options.IncludeBuiltIn,
+	}
+	if err := w.walk(ctx); err != nil {
+		return w.warnings, err
+	}
+
+	objects := selectObjects(w.objects, options)
+
+	r := newRenderer()
+	labels := map[string]map[string]bool{}
+	for _, object := range objects {
+		object.label = uniqueLabel(labels, object)
+		r.addReferences(object)
+	}
+
+	files := map[string]*hclwrite.File{}
+	var fileNames []string
+	for _, object := range objects {
+		resource := keycloakProvider.ResourcesMap[object.resourceType]
+
+		data, err := readObject(ctx, resource, keycloakClient, object)
+		if err != nil {
+			w.warn("skipping %s %s: %v", object.resourceType, object.importId, err)
+			continue
+		}
+
+		fileName := strings.TrimPrefix(object.resourceType, "keycloak_") + ".tf"
+		if _, ok := files[fileName]; !ok {
+			files[fileName] = hclwrite.NewEmptyFile()
+			fileNames = append(fileNames, fileName)
+		}
+
+		r.renderResource(files[fileName].Body(), object, resource, data)
+	}
+
+	if len(r.variables) != 0 {
+		files["variables.tf"] = hclwrite.NewEmptyFile()
+		fileNames = append(fileNames, "variables.tf")
+		r.renderVariables(files["variables.tf"].Body())
+	}
+
+	if err := os.MkdirAll(options.OutputDir, 0755); err != nil {
+		return w.warnings, err
+	}
+
+	for _, fileName := range fileNames {
+		err := os.WriteFile(filepath.Join(options.OutputDir, fileName), hclwrite.Format(files[fileName].Bytes()), 0644)
+		if err != nil {
+			return w.warnings, err
+		}
+	}
+
+	return w.warnings, nil
+}
+
+// readObject reads an object the same way terraform import does
+func readObject(ctx context.Context, resource *schema.Resource, keycloakClient *keycloak.KeycloakClient, object *object) (*schema.ResourceData, error) {
+	data := resource.Data(nil)
+	data.SetId(object.importId)
+
+	imported, err := resource.Importer.StateContext(ctx, data, keycloakClient)
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) == 0 {
+		return nil, fmt.Errorf("the object could not be imported")
+	}
+
+	data = imported[0]
+	diagnostics := resource.ReadContext(ctx, data, keycloakClient)
+	if diagnostics.HasError() {
+		return nil, fmt.Errorf("%s", diagnostics[0].Summary)
+	}
+	if data.Id() == "" {
+		return nil, fmt.Errorf("the object no longer exists")
+	}
+
+	return data, nil
+}
+
+func selectObjects(objects []*object, options *Options) []*object {
+	nameMatches := map[*object]bool{}
+	var selected []*object
+
+	for _, object := range objects {
+		// objects are walked after the objects holding them
+		nameMatches[object] = options.NamePattern == nil || options.NamePattern.MatchString(object.name) || (object.parent != nil && nameMatches[object.parent])
+
+		if nameMatches[object] && (len(options.ResourceTypes) == 0 || contains(options.ResourceTypes, object.resourceType)) {
+			selected = append(selected, object)
+		}
+	}
+
+	isSelected := map[*object]bool{}
+	for _, object := range selected {
+		isSelected[object] = true
+	}
+	for _, object := range selected {
+		if object.dependsOn != nil && !isSelected[object.dependsOn] {
+			object.dependsOn = nil
+		}
+	}
+
+	return selected
+}
+
+// uniqueLabel prefixes the label of an object with the label of the object holding it, if any, and makes it unique
+// among the resources of the same type
+func uniqueLabel(labels map[string]map[string]bool, object *object) string {
+	objectLabel := label(object.name)
+	if object.parent != nil && object.parent.resourceType != "keycloak_realm" && object.parent.label != "" {
+		objectLabel = object.parent.label + "_" + objectLabel
+	}
+
+	if _, ok := labels[object.resourceType]; !ok {
+		labels[object.resourceType] = map[string]bool{}
+	}
+
+	uniqueLabel := objectLabel
+	for i := 2; labels[object.resourceType][uniqueLabel]; i++ {
+		uniqueLabel = fmt.Sprintf("%s_%d", objectLabel, i)
+	}
+	labels[object.resourceType][uniqueLabel] = true
+
+	return uniqueLabel
+}
@@ -0,0 +1,308 @@
+package generate
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidLabelCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// renderer writes the resource blocks of the generated configuration. References to the other generated objects
+// are written instead of the ids and aliases they are known by in Keycloak.
+type renderer struct {
+	// references maps an attribute name, or an empty name for any attribute, and a value to a reference
+	references map[string]map[string]hcl.Traversal
+	variables  []string
+}
+
+func newRenderer() *renderer {
+	return &renderer{
+		references: map[string]map[string]hcl.Traversal{},
+	}
+}
+
+func resourceTraversal(object *object, attribute string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: object.resourceType},
+		hcl.TraverseAttr{Name: object.label},
+		hcl.TraverseAttr{Name: attribute},
+	}
+}
+
+func (r *renderer) addReference(attribute, value string, traversal hcl.Traversal) {
+	if _, ok := r.references[attribute]; !ok {
+		r.references[attribute] = map[string]hcl.Traversal{}
+	}
+
+	r.references[attribute][value] = traversal
+}
+
+// addReferences registers the values an object can be referenced with
+func (r *renderer) addReferences(object *object) {
+	if object.id != "" {
+		r.addReference("", object.id, resourceTraversal(object, "id"))
+	}
+
+	for _, attribute := range object.aliasAttributes {
+		reference := "alias"
+		if object.resourceType == "keycloak_realm" {
+			reference = "id"
+		}
+
+		r.addReference(attribute, object.alias, resourceTraversal(object, reference))
+	}
+}
+
+func (r *renderer) reference(object *object, attribute, value string) (hcl.Traversal, bool) {
+	// the realm holds references to flows that belong to it, referencing them back would create a cycle
+	if object.resourceType == "keycloak_realm" || value == "" {
+		return nil, false
+	}
+
+	for _, name := range []string{attribute, ""} {
+		traversal, ok := r.references[name][value]
+		if !ok {
+			continue
+		}
+
+		// an object does not refer to itself
+		if traversal.RootName() == object.resourceType && traversal[1].(hcl.TraverseAttr).Name == object.label {
+			continue
+		}
+
+		return traversal, true
+	}
+
+	return nil, false
+}
+
+// renderResource appends the resource block of an object, followed by its import block
+func (r *renderer) renderResource(body *hclwrite.Body, object *object, resource *schema.Resource, data *schema.ResourceData) {
+	values := map[string]interface{}{}
+	for name := range resource.SchemaMap() {
+		values[name] = data.Get(name)
+	}
+
+	resourceBody := body.AppendNewBlock("resource", []string{object.resourceType, object.label}).Body()
+	r.renderAttributes(resourceBody, object, resource.SchemaMap(), values, object.label)
+
+	if object.dependsOn != nil {
+		resourceBody.SetAttributeRaw("depends_on", hclwrite.TokensForTuple([]hclwrite.Tokens{
+			hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: object.dependsOn.resourceType},
+				hcl.TraverseAttr{Name: object.dependsOn.label},
+			}),
+		}))
+	}
+	body.AppendNewline()
+
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: object.resourceType},
+		hcl.TraverseAttr{Name: object.label},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(object.importId))
+	body.AppendNewline()
+}
+
+func (r *renderer) renderAttributes(body *hclwrite.Body, object *object, schemaMap map[string]*schema.Schema, values map[string]interface{}, variablePrefix string) {
+	rendered := map[string]bool{}
+
+	for _, name := range sortedAttributeNames(schemaMap) {
+		attributeSchema := schemaMap[name]
+		value := values[name]
+
+		if !isConfigurable(attributeSchema) || (!attributeSchema.Required && isDefaultValue(attributeSchema, value)) || conflictsWithRendered(attributeSchema, rendered) {
+			continue
+		}
+		rendered[name] = true
+
+		switch attributeSchema.Type {
+		case schema.TypeList, schema.TypeSet:
+			elements := value
+			if set, ok := value.(*schema.Set); ok {
+				elements = set.List()
+			}
+
+			if elementResource, ok := attributeSchema.Elem.(*schema.Resource); ok {
+				for _, element := range elements.([]interface{}) {
+					elementValues, ok := element.(map[string]interface{})
+					if !ok {
+						continue
+					}
+
+					blockBody := body.AppendNewBlock(name, nil).Body()
+					r.renderAttributes(blockBody, object, elementResource.SchemaMap(), elementValues, variablePrefix+"_"+name)
+				}
+				continue
+			}
+
+			var tokens []hclwrite.Tokens
+			for _, element := range sortedElements(attributeSchema, elements.([]interface{})) {
+				tokens = append(tokens, r.valueTokens(object, name, element))
+			}
+			body.SetAttributeRaw(name, hclwrite.TokensForTuple(tokens))
+		case schema.TypeMap:
+			var attributes []hclwrite.ObjectAttrTokens
+			elements := value.(map[string]interface{})
+
+			keys := make([]string, 0, len(elements))
+			for key := range elements {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				attributes = append(attributes, hclwrite.ObjectAttrTokens{
+					Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+					Value: r.valueTokens(object, name, elements[key]),
+				})
+			}
+			body.SetAttributeRaw(name, hclwrite.TokensForObject(attributes))
+		default:
+			if attributeSchema.Sensitive {
+				// secrets are kept out of the generated files, they are provided through variables instead
+				variable := variablePrefix + "_" + name
+				r.variables = append(r.variables, variable)
+				body.SetAttributeTraversal(name, hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: variable}})
+				continue
+			}
+
+			body.SetAttributeRaw(name, r.valueTokens(object, name, value))
+		}
+	}
+}
+
+func (r *renderer) valueTokens(object *object, attribute string, value interface{}) hclwrite.Tokens {
+	switch v := value.(type) {
+	case string:
+		if traversal, ok := r.reference(object, attribute, v); ok {
+			return hclwrite.TokensForTraversal(traversal)
+		}
+
+		return hclwrite.TokensForValue(cty.StringVal(v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberVal(big.NewFloat(v)))
+	default:
+		return hclwrite.TokensForValue(cty.StringVal(fmt.Sprint(v)))
+	}
+}
+
+// renderVariables appends the declaration of the variables holding the secrets of the generated resources
+func (r *renderer) renderVariables(body *hclwrite.Body) {
+	for _, variable := range r.variables {
+		variableBody := body.AppendNewBlock("variable", []string{variable}).Body()
+		variableBody.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		variableBody.SetAttributeValue("sensitive", cty.True)
+		body.AppendNewline()
+	}
+}
+
+// isConfigurable tells whether an attribute can be written in a configuration
+func isConfigurable(attributeSchema *schema.Schema) bool {
+	if attributeSchema.Computed && !attributeSchema.Optional {
+		return false
+	}
+
+	// write-only attributes are never read back, and deprecated ones have replacements that are rendered instead
+	return !attributeSchema.WriteOnly && attributeSchema.Deprecated == ""
+}
+
+// isDefaultValue tells whether a value is the one an attribute takes when it is omitted from the configuration
+func isDefaultValue(attributeSchema *schema.Schema, value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case *schema.Set:
+		return v.Len() == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	if attributeSchema.Default != nil {
+		return fmt.Sprint(attributeSchema.Default) == fmt.Sprint(value)
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	}
+
+	return false
+}
+
+func conflictsWithRendered(attributeSchema *schema.Schema, rendered map[string]bool) bool {
+	for _, conflict := range attributeSchema.ConflictsWith {
+		if rendered[conflict] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortedAttributeNames sorts the required attributes first, to keep the identifying ones at the top of the blocks
+func sortedAttributeNames(schemaMap map[string]*schema.Schema) []string {
+	names := make([]string, 0, len(schemaMap))
+	for name := range schemaMap {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if schemaMap[names[i]].Required != schemaMap[names[j]].Required {
+			return schemaMap[names[i]].Required
+		}
+
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
+// sortedElements sorts the elements of sets, whose order is meaningless, to get a stable output
+func sortedElements(attributeSchema *schema.Schema, elements []interface{}) []interface{} {
+	if attributeSchema.Type != schema.TypeSet {
+		return elements
+	}
+
+	sorted := append([]interface{}{}, elements...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return fmt.Sprint(sorted[i]) < fmt.Sprint(sorted[j])
+	})
+
+	return sorted
+}
+
+// label turns a name into a valid resource label
+func label(name string) string {
+	label := strings.Trim(invalidLabelCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		return "object"
+	}
+
+	if label[0] == '-' || (label[0] >= '0' && label[0] <= '9') {
+		return "_" + label
+	}
+
+	return label
+}
@@ -0,0 +1,82 @@
+package generate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestLabel(t *testing.T) {
+	for name, expected := range map[string]string{
+		"my-client":       "my-client",
+		"My Client":       "my_client",
+		"parent/child":    "parent_child",
+		"${realm}":        "realm",
+		"123-client":      "_123-client",
+		"-client":         "_-client",
+		"":                "object",
+		"Ünïcode client!": "n_code_client",
+	} {
+		if actual := label(name); actual != expected {
+			t.Errorf("expected label of %q to be %q, got %q", name, expected, actual)
+		}
+	}
+}
+
+func TestRenderResource(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"realm_id":    {Type: schema.TypeString, Required: true},
+			"parent_id":   {Type: schema.TypeString, Optional: true},
+			"name":        {Type: schema.TypeString, Required: true},
+			"description": {Type: schema.TypeString, Optional: true},
+			"enabled":     {Type: schema.TypeBool, Optional: true, Default: true},
+			"secret":      {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"path":        {Type: schema.TypeString, Computed: true},
+		},
+	}
+
+	data := resource.Data(nil)
+	data.SetId("child-id")
+	data.Set("realm_id", "my-realm")
+	data.Set("parent_id", "parent-id")
+	data.Set("name", "child")
+	data.Set("enabled", true)
+	data.Set("secret", "s3cr3t")
+	data.Set("path", "/parent/child")
+
+	realm := &object{resourceType: "keycloak_realm", label: "my-realm", alias: "my-realm", aliasAttributes: []string{"realm_id"}}
+	parent := &object{resourceType: "keycloak_group", label: "parent", id: "parent-id"}
+	child := &object{resourceType: "keycloak_group", label: "parent_child", id: "child-id", importId: "my-realm/child-id", dependsOn: parent}
+
+	r := newRenderer()
+	for _, object := range []*object{realm, parent, child} {
+		r.addReferences(object)
+	}
+
+	file := hclwrite.NewEmptyFile()
+	r.renderResource(file.Body(), child, resource, data)
+
+	expected := `resource "keycloak_group" "parent_child" {
+  name       = "child"
+  realm_id   = keycloak_realm.my-realm.id
+  parent_id  = keycloak_group.parent.id
+  secret     = var.parent_child_secret
+  depends_on = [keycloak_group.parent]
+}
+
+import {
+  to = keycloak_group.parent_child
+  id = "my-realm/child-id"
+}
+`
+	if actual := strings.TrimSpace(string(hclwrite.Format(file.Bytes()))); actual != strings.TrimSpace(expected) {
+		t.Errorf("unexpected configuration, expected:\n%s\ngot:\n%s", expected, actual)
+	}
+
+	if len(r.variables) != 1 || r.variables[0] != "parent_child_secret" {
+		t.Errorf("expected the secret to be declared as a variable, got %v", r.variables)
+	}
+}
@@ -0,0 +1,573 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+const (
+	keyProviderType               = "org.keycloak.keys.KeyProvider"
+	userStorageProviderType       = "org.keycloak.storage.UserStorageProvider"
+	ldapStorageMapperProviderType = "org.keycloak.storage.ldap.mappers.LDAPStorageMapper"
+)
+
+// attributes holding the alias of an authentication flow or subflow
+var flowAliasAttributes = []string{"parent_flow_alias", "first_broker_login_flow_alias", "post_broker_login_flow_alias"}
+
+var protocolMapperResourceTypes = map[string]string{
+	"oidc-acr-mapper":                     "keycloak_openid_acr_protocol_mapper",
+	"oidc-address-mapper":                 "keycloak_openid_address_protocol_mapper",
+	"oidc-allowed-origins-mapper":         "keycloak_openid_allowed_origins_protocol_mapper",
+	"oidc-audience-mapper":                "keycloak_openid_audience_protocol_mapper",
+	"oidc-audience-resolve-mapper":        "keycloak_openid_audience_resolve_protocol_mapper",
+	"oidc-claims-param-token-mapper":      "keycloak_openid_claims_parameter_protocol_mapper",
+	"oidc-full-name-mapper":               "keycloak_openid_full_name_protocol_mapper",
+	"oidc-group-membership-mapper":        "keycloak_openid_group_membership_protocol_mapper",
+	"oidc-hardcoded-claim-mapper":         "keycloak_openid_hardcoded_claim_protocol_mapper",
+	"oidc-hardcoded-role-mapper":          "keycloak_openid_hardcoded_role_protocol_mapper",
+	"oidc-organization-membership-mapper": "keycloak_openid_organization_membership_protocol_mapper",
+	"oidc-role-name-mapper":               "keycloak_openid_role_name_protocol_mapper",
+	"oidc-script-based-protocol-mapper":   "keycloak_openid_script_protocol_mapper",
+	"oidc-sha256-pairwise-sub-mapper":     "keycloak_openid_sha256_pairwise_sub_protocol_mapper",
+	"oidc-usermodel-attribute-mapper":     "keycloak_openid_user_attribute_protocol_mapper",
+	"oidc-usermodel-client-role-mapper":   "keycloak_openid_user_client_role_protocol_mapper",
+	"oidc-usermodel-property-mapper":      "keycloak_openid_user_property_protocol_mapper",
+	"oidc-usermodel-realm-role-mapper":    "keycloak_openid_user_realm_role_protocol_mapper",
+	"oidc-usersessionmodel-note-mapper":   "keycloak_openid_user_session_note_protocol_mapper",
+	"saml-audience-mapper":                "keycloak_saml_audience_protocol_mapper",
+	"saml-group-membership-mapper":        "keycloak_saml_group_membership_protocol_mapper",
+	"saml-hardcode-attribute-mapper":      "keycloak_saml_hardcoded_attribute_protocol_mapper",
+	"saml-hardcode-role-mapper":           "keycloak_saml_hardcoded_role_protocol_mapper",
+	"saml-javascript-mapper":              "keycloak_saml_script_protocol_mapper",
+	"saml-role-list-mapper":               "keycloak_saml_role_list_protocol_mapper",
+	"saml-role-name-mapper":               "keycloak_saml_role_name_protocol_mapper",
+	"saml-user-attribute-mapper":          "keycloak_saml_user_attribute_protocol_mapper",
+	"saml-user-property-mapper":           "keycloak_saml_user_property_protocol_mapper",
+}
+
+var ldapMapperResourceTypes = map[string]string{
+	"certificate-ldap-mapper":              "keycloak_ldap_certificate_mapper",
+	"full-name-ldap-mapper":                "keycloak_ldap_full_name_mapper",
+	"group-ldap-mapper":                    "keycloak_ldap_group_mapper",
+	"hardcoded-attribute-mapper":           "keycloak_hardcoded_attribute_mapper",
+	"hardcoded-ldap-attribute-mapper":      "keycloak_ldap_hardcoded_attribute_mapper",
+	"hardcoded-ldap-group-mapper":          "keycloak_ldap_hardcoded_group_mapper",
+	"hardcoded-ldap-role-mapper":           "keycloak_ldap_hardcoded_role_mapper",
+	"kerberos-principal-attribute-mapper":  "keycloak_ldap_kerberos_principal_attribute_mapper",
+	"msad-lds-user-account-control-mapper": "keycloak_ldap_msad_lds_user_account_control_mapper",
+	"msad-user-account-control-mapper":     "keycloak_ldap_msad_user_account_control_mapper",
+	"role-ldap-mapper":                     "keycloak_ldap_role_mapper",
+	"user-attribute-ldap-mapper":           "keycloak_ldap_user_attribute_mapper",
+}
+
+var keystoreResourceTypes = map[string]string{
+	"aes-generated":     "keycloak_realm_keystore_aes_generated",
+	"ecdsa-generated":   "keycloak_realm_keystore_ecdsa_generated",
+	"hmac-generated":    "keycloak_realm_keystore_hmac_generated",
+	"java-keystore":     "keycloak_realm_keystore_java_keystore",
+	"rsa":               "keycloak_realm_keystore_rsa",
+	"rsa-generated":     "keycloak_realm_keystore_rsa_generated",
+	"rsa-enc-generated": "keycloak_realm_keystore_rsa_enc_generated",
+}
+
+var identityProviderResourceTypes = map[string]string{
+	"bitbucket":               "keycloak_bitbucket_identity_provider",
+	"facebook":                "keycloak_facebook_identity_provider",
+	"github":                  "keycloak_github_identity_provider",
+	"gitlab":                  "keycloak_gitlab_identity_provider",
+	"google":                  "keycloak_oidc_google_identity_provider",
+	"instagram":               "keycloak_instagram_identity_provider",
+	"keycloak-oidc":           "keycloak_oidc_identity_provider",
+	"kubernetes":              "keycloak_kubernetes_identity_provider",
+	"linkedin-openid-connect": "keycloak_linkedin_identity_provider",
+	"microsoft":               "keycloak_microsoft_identity_provider",
+	"oidc":                    "keycloak_oidc_identity_provider",
+	"paypal":                  "keycloak_paypal_identity_provider",
+	"saml":                    "keycloak_saml_identity_provider",
+	"stackoverflow":           "keycloak_stackoverflow_identity_provider",
+	"twitter":                 "keycloak_twitter_identity_provider",
+}
+
+var identityProviderMapperResourceTypes = map[string]string{
+	"oidc-advanced-group-idp-mapper":  "keycloak_oidc_advanced_group_identity_provider_mapper",
+	"oidc-advanced-role-idp-mapper":   "keycloak_oidc_advanced_role_identity_provider_mapper",
+	"oidc-username-idp-mapper":        "keycloak_oidc_username_identity_provider_mapper",
+	"saml-advanced-role-idp-mapper":   "keycloak_saml_advanced_role_identity_provider_mapper",
+	"saml-xpath-attribute-idp-mapper": "keycloak_saml_xpath_attribute_identity_provider_mapper",
+}
+
+// objects that Keycloak creates along with every realm
+var (
+	builtInClients      = []string{"account", "account-console", "admin-cli", "broker", "realm-management", "security-admin-console"}
+	builtInClientScopes = []string{"acr", "address", "basic", "email", "microprofile-jwt", "offline_access", "organization", "phone", "profile", "role_list", "roles", "saml_organization", "service_account", "web-origins"}
+	builtInRealmRoles   = []string{"offline_access", "uma_authorization"}
+)
+
+// object is a Keycloak object that is turned into a resource block and an import block
+type object struct {
+	resourceType string
+	// name is used to build the label of the resource and to filter the objects
+	name     string
+	importId string
+	// id is the value other resources refer to the object with, it is replaced by a reference in the configuration
+	id string
+	// alias is the value the attributes listed in aliasAttributes refer to the object with
+	alias           string
+	aliasAttributes []string
+	parent          *object
+	dependsOn       *object
+	label           string
+}
+
+type walker struct {
+	keycloakClient *keycloak.KeycloakClient
+	realm          string
+	includeBuiltIn bool
+	objects        []*object
+	warnings       []string
+}
+
+func (w *walker) add(object *object) *object {
+	w.objects = append(w.objects, object)
+
+	return object
+}
+
+func (w *walker) warn(format string, args ...interface{}) {
+	w.warnings = append(w.warnings, fmt.Sprintf(format, args...))
+}
+
+func (w *walker) walk(ctx context.Context) error {
+	realm, err := w.keycloakClient.GetRealm(ctx, w.realm)
+	if err != nil {
+		return err
+	}
+
+	realmObject := w.add(&object{
+		resourceType:    "keycloak_realm",
+		name:            realm.Realm,
+		importId:        realm.Realm,
+		alias:           realm.Realm,
+		aliasAttributes: []string{"realm_id", "realm"},
+	})
+	w.add(&object{
+		resourceType: "keycloak_realm_events",
+		name:         "events",
+		importId:     realm.Realm,
+		parent:       realmObject,
+	})
+
+	walkers := []func(context.Context, *object) error{
+		w.walkKeystores,
+		w.walkClientScopes,
+		w.walkClients,
+		w.walkRealmRoles,
+		w.walkGroups,
+		w.walkAuthenticationFlows,
+		w.walkIdentityProviders,
+		w.walkUserFederations,
+	}
+	for _, walk := range walkers {
+		if err := walk(ctx, realmObject); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *walker) walkKeystores(ctx context.Context, realm *object) error {
+	keystores, err := w.keycloakClient.ListComponents(ctx, w.realm, "", keyProviderType)
+	if err != nil {
+		return err
+	}
+
+	for _, keystore := range keystores {
+		resourceType, ok := keystoreResourceTypes[keystore.ProviderId]
+		if !ok {
+			w.warn("skipping keystore %s: provider %s is not supported", keystore.Name, keystore.ProviderId)
+			continue
+		}
+
+		w.add(&object{
+			resourceType: resourceType,
+			name:         keystore.Name,
+			importId:     fmt.Sprintf("%s/%s", w.realm, keystore.Id),
+			id:           keystore.Id,
+			parent:       realm,
+		})
+	}
+
+	return nil
+}
+
+func (w *walker) walkClientScopes(ctx context.Context, realm *object) error {
+	clientScopes, err := w.keycloakClient.ListClientScopes(ctx, w.realm)
+	if err != nil {
+		return err
+	}
+
+	for _, clientScope := range clientScopes {
+		if !w.includeBuiltIn && contains(builtInClientScopes, clientScope.Name) {
+			continue
+		}
+
+		var resourceType string
+		switch clientScope.Protocol {
+		case "openid-connect":
+			resourceType = "keycloak_openid_client_scope"
+		case "saml":
+			resourceType = "keycloak_saml_client_scope"
+		default:
+			w.warn("skipping client scope %s: protocol %s is not supported", clientScope.Name, clientScope.Protocol)
+			continue
+		}
+
+		clientScopeObject := w.add(&object{
+			resourceType: resourceType,
+			name:         clientScope.Name,
+			importId:     fmt.Sprintf("%s/%s", w.realm, clientScope.Id),
+			id:           clientScope.Id,
+			parent:       realm,
+		})
+
+		if err := w.walkProtocolMappers(ctx, clientScopeObject, "", clientScope.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *walker) walkClients(ctx context.Context, realm *object) error {
+	clients, err := w.keycloakClient.ListGenericClients(ctx, w.realm)
+	if err != nil {
+		return err
+	}
+
+	for _, client := range clients {
+		// the master realm holds a client per realm to administrate it
+		if !w.includeBuiltIn && (contains(builtInClients, client.ClientId) || (w.realm == "master" && strings.HasSuffix(client.ClientId, "-realm"))) {
+			continue
+		}
+
+		var resourceType string
+		switch client.Protocol {
+		case "openid-connect":
+			resourceType = "keycloak_openid_client"
+		case "saml":
+			resourceType = "keycloak_saml_client"
+		default:
+			w.warn("skipping client %s: protocol %s is not supported", client.ClientId, client.Protocol)
+			continue
+		}
+
+		clientObject := w.add(&object{
+			resourceType: resourceType,
+			name:         client.ClientId,
+			importId:     fmt.Sprintf("%s/%s", w.realm, client.Id),
+			id:           client.Id,
+			parent:       realm,
+		})
+
+		if err := w.walkProtocolMappers(ctx, clientObject, client.Id, ""); err != nil {
+			return err
+		}
+
+		roles, err := w.keycloakClient.GetClientRoles(ctx, w.realm, []*keycloak.OpenidClient{{Id: client.Id, RealmId: w.realm}})
+		if err != nil {
+			return err
+		}
+
+		for _, role := range roles {
+			w.add(&object{
+				resourceType: "keycloak_role",
+				name:         role.Name,
+				importId:     fmt.Sprintf("%s/%s", w.realm, role.Id),
+				id:           role.Id,
+				parent:       clientObject,
+			})
+		}
+	}
+
+	return nil
+}
+
+func (w *walker) walkProtocolMappers(ctx context.Context, parent *object, clientId, clientScopeId string) error {
+	protocolMappers, err := w.keycloakClient.ListGenericProtocolMappers(ctx, w.realm, clientId, clientScopeId)
+	if err != nil {
+		return err
+	}
+
+	parentPath := fmt.Sprintf("client/%s", clientId)
+	if clientScopeId != "" {
+		parentPath = fmt.Sprintf("client-scope/%s", clientScopeId)
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		resourceType, ok := protocolMapperResourceTypes[protocolMapper.ProtocolMapper]
+		if !ok {
+			resourceType = "keycloak_generic_protocol_mapper"
+		}
+
+		w.add(&object{
+			resourceType: resourceType,
+			name:         protocolMapper.Name,
+			importId:     fmt.Sprintf("%s/%s/%s", w.realm, parentPath, protocolMapper.Id),
+			id:           protocolMapper.Id,
+			parent:       parent,
+		})
+	}
+
+	return nil
+}
+
+func (w *walker) walkRealmRoles(ctx context.Context, realm *object) error {
+	roles, err := w.keycloakClient.GetRealmRoles(ctx, w.realm)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		if !w.includeBuiltIn && (contains(builtInRealmRoles, role.Name) || role.Name == "default-roles-"+strings.ToLower(w.realm)) {
+			continue
+		}
+
+		w.add(&object{
+			resourceType: "keycloak_role",
+			name:         role.Name,
+			importId:     fmt.Sprintf("%s/%s", w.realm, role.Id),
+			id:           role.Id,
+			parent:       realm,
+		})
+	}
+
+	return nil
+}
+
+func (w *walker) walkGroups(ctx context.Context, realm *object) error {
+	// parents come first, so that they can be referenced by their subgroups
+	groups, err := w.keycloakClient.GetFlattenedGroupsHierarchy(ctx, w.realm, true)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		w.add(&object{
+			resourceType: "keycloak_group",
+			name:         strings.TrimPrefix(group.Path, "/"),
+			importId:     fmt.Sprintf("%s/%s", w.realm, group.Id),
+			id:           group.Id,
+			parent:       realm,
+		})
+	}
+
+	return nil
+}
+
+func (w *walker) walkAuthenticationFlows(ctx context.Context, realm *object) error {
+	flows, err := w.keycloakClient.ListAuthenticationFlows(ctx, w.realm)
+	if err != nil {
+		return err
+	}
+
+	for _, flow := range flows {
+		if !flow.TopLevel || (flow.BuiltIn && !w.includeBuiltIn) {
+			continue
+		}
+
+		flowObject := w.add(&object{
+			resourceType:    "keycloak_authentication_flow",
+			name:            flow.Alias,
+			importId:        fmt.Sprintf("%s/%s", w.realm, flow.Id),
+			id:              flow.Id,
+			alias:           flow.Alias,
+			aliasAttributes: flowAliasAttributes,
+			parent:          realm,
+		})
+
+		if err := w.walkAuthenticationExecutions(ctx, flowObject); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkAuthenticationExecutions walks the executions of a flow, which Keycloak returns flattened with their nesting level
+func (w *walker) walkAuthenticationExecutions(ctx context.Context, flow *object) error {
+	executions, err := w.keycloakClient.ListAuthenticationExecutions(ctx, w.realm, flow.alias)
+	if err != nil {
+		return err
+	}
+
+	parents := []*object{flow}
+	lastChildren := map[*object]*object{}
+
+	for _, execution := range executions {
+		if execution.Level >= len(parents) {
+			w.warn("skipping execution %s of flow %s: its parent flow could not be found", execution.DisplayName, flow.alias)
+			continue
+		}
+
+		parents = parents[:execution.Level+1]
+		parent := parents[execution.Level]
+
+		var executionObject *object
+		if execution.AuthenticationFlow {
+			executionObject = w.add(&object{
+				resourceType:    "keycloak_authentication_subflow",
+				name:            execution.DisplayName,
+				importId:        fmt.Sprintf("%s/%s/%s", w.realm, parent.alias, execution.FlowId),
+				id:              execution.FlowId,
+				alias:           execution.DisplayName,
+				aliasAttributes: flowAliasAttributes,
+				parent:          parent,
+				dependsOn:       lastChildren[parent],
+			})
+			parents = append(parents, executionObject)
+		} else {
+			executionObject = w.add(&object{
+				resourceType: "keycloak_authentication_execution",
+				name:         execution.ProviderId,
+				importId:     fmt.Sprintf("%s/%s/%s", w.realm, parent.alias, execution.Id),
+				id:           execution.Id,
+				parent:       parent,
+				dependsOn:    lastChildren[parent],
+			})
+		}
+		lastChildren[parent] = executionObject
+
+		if execution.AuthenticationConfig != "" {
+			w.add(&object{
+				resourceType: "keycloak_authentication_execution_config",
+				name:         "config",
+				importId:     fmt.Sprintf("%s/%s/%s", w.realm, execution.Id, execution.AuthenticationConfig),
+				id:           execution.AuthenticationConfig,
+				parent:       executionObject,
+			})
+		}
+	}
+
+	return nil
+}
+
+func (w *walker) walkIdentityProviders(ctx context.Context, realm *object) error {
+	identityProviders, err := w.keycloakClient.ListIdentityProviders(ctx, w.realm)
+	if err != nil {
+		return err
+	}
+
+	for _, identityProvider := range identityProviders {
+		resourceType, ok := identityProviderResourceTypes[identityProvider.ProviderId]
+		if !ok {
+			w.warn("skipping identity provider %s: provider %s is not supported", identityProvider.Alias, identityProvider.ProviderId)
+			continue
+		}
+
+		identityProviderObject := w.add(&object{
+			resourceType:    resourceType,
+			name:            identityProvider.Alias,
+			importId:        fmt.Sprintf("%s/%s", w.realm, identityProvider.Alias),
+			alias:           identityProvider.Alias,
+			aliasAttributes: []string{"identity_provider_alias"},
+			parent:          realm,
+		})
+
+		mappers, err := w.keycloakClient.GetIdentityProviderMappers(ctx, w.realm, identityProvider.Alias)
+		if err != nil {
+			return err
+		}
+
+		for _, mapper := range mappers {
+			resourceType, ok := identityProviderMapperResourceTypes[mapper.IdentityProviderMapper]
+			if !ok {
+				resourceType = "keycloak_custom_identity_provider_mapper"
+			}
+
+			w.add(&object{
+				resourceType: resourceType,
+				name:         mapper.Name,
+				importId:     fmt.Sprintf("%s/%s/%s", w.realm, identityProvider.Alias, mapper.Id),
+				id:           mapper.Id,
+				parent:       identityProviderObject,
+			})
+		}
+	}
+
+	return nil
+}
+
+func (w *walker) walkUserFederations(ctx context.Context, realm *object) error {
+	userFederations, err := w.keycloakClient.ListComponents(ctx, w.realm, "", userStorageProviderType)
+	if err != nil {
+		return err
+	}
+
+	for _, userFederation := range userFederations {
+		if userFederation.ProviderId == "kerberos" {
+			w.add(&object{
+				resourceType: "keycloak_kerberos_user_federation",
+				name:         userFederation.Name,
+				importId:     fmt.Sprintf("%s/%s", w.realm, userFederation.Id),
+				id:           userFederation.Id,
+				parent:       realm,
+			})
+			continue
+		}
+
+		if userFederation.ProviderId != "ldap" {
+			w.add(&object{
+				resourceType: "keycloak_custom_user_federation",
+				name:         userFederation.Name,
+				importId:     fmt.Sprintf("%s/%s", w.realm, userFederation.Id),
+				id:           userFederation.Id,
+				parent:       realm,
+			})
+			continue
+		}
+
+		userFederationObject := w.add(&object{
+			resourceType: "keycloak_ldap_user_federation",
+			name:         userFederation.Name,
+			importId:     fmt.Sprintf("%s/%s", w.realm, userFederation.Id),
+			id:           userFederation.Id,
+			parent:       realm,
+		})
+
+		mappers, err := w.keycloakClient.ListComponents(ctx, w.realm, userFederation.Id, ldapStorageMapperProviderType)
+		if err != nil {
+			return err
+		}
+
+		for _, mapper := range mappers {
+			resourceType, ok := ldapMapperResourceTypes[mapper.ProviderId]
+			if !ok {
+				resourceType = "keycloak_ldap_custom_mapper"
+			}
+
+			w.add(&object{
+				resourceType: resourceType,
+				name:         mapper.Name,
+				importId:     fmt.Sprintf("%s/%s/%s", w.realm, userFederation.Id, mapper.Id),
+				id:           mapper.Id,
+				parent:       userFederationObject,
+			})
+		}
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,149 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AdminPermissionsClientId is the client holding the fine-grained admin permissions v2 of a realm, with their policies
+const AdminPermissionsClientId = "admin-permissions"
+
+// AdminPermissionResourceTypes are the kinds of objects fine-grained admin permissions v2 apply to
+var AdminPermissionResourceTypes = []string{"Users", "Groups", "Clients", "Roles"}
+
+// AdminPermission is a fine-grained admin permission v2, which grants scopes over all the objects of a resource type, or
+// over specific objects of that type
+type AdminPermission struct {
+	Id               string   `json:"id,omitempty"`
+	RealmId          string   `json:"-"`
+	ResourceServerId string   `json:"-"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	DecisionStrategy string   `json:"decisionStrategy"`
+	ResourceType     string   `json:"resourceType"`
+	Resources        []string `json:"resources"` // ids of the objects, all the objects of the resource type when empty
+	Scopes           []string `json:"scopes"`
+	Policies         []string `json:"policies"`
+	Type             string   `json:"type"`
+}
+
+// GetAdminPermissionsClient returns the admin-permissions client of a realm, which only exists once fine-grained admin
+// permissions v2 are enabled on the realm
+func (keycloakClient *KeycloakClient) GetAdminPermissionsClient(ctx context.Context, realmId string) (*OpenidClient, error) {
+	var clients []*OpenidClient
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients", realmId), &clients, map[string]string{"clientId": AdminPermissionsClientId})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("client %s does not exist in realm %s", AdminPermissionsClientId, realmId)
+	}
+
+	clients[0].RealmId = realmId
+
+	return clients[0], nil
+}
+
+// GetAdminPermissionResourceTypeScopes returns the names of the scopes which can be granted over a resource type
+func (keycloakClient *KeycloakClient) GetAdminPermissionResourceTypeScopes(ctx context.Context, realmId, resourceServerId, resourceType string) ([]string, error) {
+	var resources []*OpenidClientAuthorizationResource
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/resource", realmId, resourceServerId), &resources, map[string]string{"name": resourceType, "exactName": "true"})
+	if err != nil {
+		return nil, err
+	}
+
+	var scopes []string
+	for _, resource := range resources {
+		if resource.Name != resourceType {
+			continue
+		}
+
+		for _, scope := range resource.Scopes {
+			scopes = append(scopes, scope.Name)
+		}
+	}
+
+	return scopes, nil
+}
+
+func (keycloakClient *KeycloakClient) NewAdminPermission(ctx context.Context, permission *AdminPermission) error {
+	permission.Type = "scope"
+
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope", permission.RealmId, permission.ResourceServerId), permission)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, permission)
+}
+
+func (keycloakClient *KeycloakClient) GetAdminPermission(ctx context.Context, realmId, resourceServerId, id string) (*AdminPermission, error) {
+	permission := AdminPermission{
+		RealmId:          realmId,
+		ResourceServerId: resourceServerId,
+	}
+
+	var policies []OpenidClientAuthorizationPolicy
+	var resources []OpenidClientAuthorizationResource
+	var scopes []OpenidClientAuthorizationScope
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", realmId, resourceServerId, id), &permission, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/policy/%s/associatedPolicies", realmId, resourceServerId, id), &policies, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/resources", realmId, resourceServerId, id), &resources, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s/scopes", realmId, resourceServerId, id), &scopes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	permission.Policies = nil
+	for _, policy := range policies {
+		permission.Policies = append(permission.Policies, policy.Id)
+	}
+
+	// the resources of a permission are all of its resource type, in case the representation doesn't hold it
+	if permission.ResourceType == "" && len(resources) != 0 {
+		permission.ResourceType = resources[0].Type
+	}
+
+	// the resources of specific objects are named after the ids of the objects, while the resource of the resource type
+	// itself is named after the type and stands for all its objects
+	permission.Resources = nil
+	for _, resource := range resources {
+		if resource.Name != permission.ResourceType {
+			permission.Resources = append(permission.Resources, resource.Name)
+		}
+	}
+
+	permission.Scopes = nil
+	for _, scope := range scopes {
+		permission.Scopes = append(permission.Scopes, scope.Name)
+	}
+
+	return &permission, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateAdminPermission(ctx context.Context, permission *AdminPermission) error {
+	permission.Type = "scope"
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/scope/%s", permission.RealmId, permission.ResourceServerId, permission.Id), permission)
+}
+
+func (keycloakClient *KeycloakClient) DeleteAdminPermission(ctx context.Context, realmId, resourceServerId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/permission/%s", realmId, resourceServerId, id), nil)
+}
@@ -41,6 +41,7 @@ type AuthenticationExecutionInfo struct {
 	RealmId              string `json:"-"`
 	ParentFlowAlias      string `json:"-"`
 	Alias                string `json:"alias"`
+	DisplayName          string `json:"displayName"`
 	AuthenticationConfig string `json:"authenticationConfig"`
 	AuthenticationFlow   bool   `json:"authenticationFlow"`
 	Configurable         bool   `json:"configurable"`
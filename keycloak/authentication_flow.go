@@ -3,6 +3,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -44,6 +45,38 @@ func (keycloakClient *KeycloakClient) NewAuthenticationFlow(ctx context.Context,
 	return nil
 }
 
+// CopyAuthenticationFlow creates the given flow as a copy of the flow with the given alias, with all its executions,
+// subflows and configs. The copy keeps the type of the copied flow, while its description is the given one.
+func (keycloakClient *KeycloakClient) CopyAuthenticationFlow(ctx context.Context, sourceAlias string, authenticationFlow *AuthenticationFlow) error {
+	source, err := keycloakClient.GetAuthenticationFlowFromAlias(ctx, authenticationFlow.RealmId, sourceAlias)
+	if err != nil {
+		return err
+	}
+
+	if source.ProviderId != authenticationFlow.ProviderId {
+		return fmt.Errorf("authentication flow %s can't be copied into a flow with provider id %s, as its provider id is %s", sourceAlias, authenticationFlow.ProviderId, source.ProviderId)
+	}
+
+	copyRequest := map[string]string{
+		"newName": authenticationFlow.Alias,
+	}
+
+	_, _, err = keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s/copy", authenticationFlow.RealmId, url.PathEscape(sourceAlias)), copyRequest)
+	if err != nil {
+		return err
+	}
+
+	// the copy endpoint doesn't return the location of the new flow
+	authenticationFlowCopy, err := keycloakClient.GetAuthenticationFlowFromAlias(ctx, authenticationFlow.RealmId, authenticationFlow.Alias)
+	if err != nil {
+		return err
+	}
+
+	authenticationFlow.Id = authenticationFlowCopy.Id
+
+	return keycloakClient.UpdateAuthenticationFlow(ctx, authenticationFlow)
+}
+
 func (keycloakClient *KeycloakClient) GetAuthenticationFlow(ctx context.Context, realmId, id string) (*AuthenticationFlow, error) {
 	var authenticationFlow AuthenticationFlow
 	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/flows/%s", realmId, id), &authenticationFlow, nil)
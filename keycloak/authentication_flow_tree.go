@@ -0,0 +1,390 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+)
+
+// AuthenticationFlowTreeExecution is an execution of an authentication flow managed as a whole, running either an
+// authenticator or a subflow
+type AuthenticationFlowTreeExecution struct {
+	Id            string
+	Authenticator string
+	Requirement   string
+	Config        *AuthenticationExecutionConfig // nil when the execution has no config
+	SubFlow       *AuthenticationFlowTreeSubFlow // nil when the execution runs an authenticator
+}
+
+type AuthenticationFlowTreeSubFlow struct {
+	Id          string
+	Alias       string
+	ProviderId  string
+	Description string
+	Executions  []*AuthenticationFlowTreeExecution
+}
+
+// key identifies an execution among its siblings. Subflows whose type or authenticator changed can't be updated, so
+// they don't match their previous version and are recreated.
+func (execution *AuthenticationFlowTreeExecution) key() string {
+	if execution.SubFlow != nil {
+		return fmt.Sprintf("flow/%s/%s/%s", execution.SubFlow.Alias, execution.SubFlow.ProviderId, execution.Authenticator)
+	}
+
+	return "authenticator/" + execution.Authenticator
+}
+
+// listAuthenticationFlowTreeLevel lists the direct executions of a flow in their order, without the executions of
+// their subflows
+func (keycloakClient *KeycloakClient) listAuthenticationFlowTreeLevel(ctx context.Context, realmId, flowAlias string) ([]*AuthenticationFlowTreeExecution, error) {
+	list, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, flowAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Sort(list)
+
+	var executions []*AuthenticationFlowTreeExecution
+	for _, info := range list {
+		if info.Level != 0 {
+			continue
+		}
+
+		execution := &AuthenticationFlowTreeExecution{
+			Id:            info.Id,
+			Authenticator: info.ProviderId,
+			Requirement:   info.Requirement,
+		}
+
+		if info.AuthenticationFlow {
+			flow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, info.FlowId)
+			if err != nil {
+				return nil, err
+			}
+
+			// the execution info of a subflow doesn't reliably hold the authenticator of the execution
+			subFlowExecution, err := keycloakClient.GetAuthenticationExecution(ctx, realmId, flowAlias, info.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			execution.Authenticator = subFlowExecution.Authenticator
+			execution.SubFlow = &AuthenticationFlowTreeSubFlow{
+				Id:          flow.Id,
+				Alias:       flow.Alias,
+				ProviderId:  flow.ProviderId,
+				Description: flow.Description,
+			}
+		}
+
+		if info.AuthenticationConfig != "" {
+			execution.Config = &AuthenticationExecutionConfig{
+				RealmId:     realmId,
+				ExecutionId: info.Id,
+				Id:          info.AuthenticationConfig,
+			}
+
+			err = keycloakClient.GetAuthenticationExecutionConfig(ctx, execution.Config)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		executions = append(executions, execution)
+	}
+
+	return executions, nil
+}
+
+// GetAuthenticationFlowTree returns the executions of a flow in their order, with the executions of their subflows
+func (keycloakClient *KeycloakClient) GetAuthenticationFlowTree(ctx context.Context, realmId, flowAlias string) ([]*AuthenticationFlowTreeExecution, error) {
+	executions, err := keycloakClient.listAuthenticationFlowTreeLevel(ctx, realmId, flowAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, execution := range executions {
+		if execution.SubFlow == nil {
+			continue
+		}
+
+		execution.SubFlow.Executions, err = keycloakClient.GetAuthenticationFlowTree(ctx, realmId, execution.SubFlow.Alias)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return executions, nil
+}
+
+// ReconcileAuthenticationFlowTree makes the executions of a flow match the given ones, recursively. Existing executions
+// are kept when they match a wanted execution, so that only the changed executions are created, updated or deleted,
+// and are then moved into the wanted order. The ids of the wanted executions, subflows and configs are filled in.
+func (keycloakClient *KeycloakClient) ReconcileAuthenticationFlowTree(ctx context.Context, realmId, flowAlias string, executions []*AuthenticationFlowTreeExecution) error {
+	// subflow aliases are unique within a realm, so the unmatched executions of the whole tree are deleted before
+	// anything is created, which lets a subflow be recreated at another level under the same alias
+	err := keycloakClient.deleteUnmatchedAuthenticationFlowTreeExecutions(ctx, realmId, flowAlias, executions)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.reconcileAuthenticationFlowTreeLevel(ctx, realmId, flowAlias, executions)
+}
+
+// deleteUnmatchedAuthenticationFlowTreeExecutions deletes the executions of a flow which don't match any wanted
+// execution, then does the same within the subflows which are kept
+func (keycloakClient *KeycloakClient) deleteUnmatchedAuthenticationFlowTreeExecutions(ctx context.Context, realmId, flowAlias string, executions []*AuthenticationFlowTreeExecution) error {
+	current, err := keycloakClient.listAuthenticationFlowTreeLevel(ctx, realmId, flowAlias)
+	if err != nil {
+		return err
+	}
+
+	matches := matchAuthenticationFlowTreeExecutions(current, executions)
+
+	matched := map[int]bool{}
+	for _, currentIndex := range matches {
+		if currentIndex != -1 {
+			matched[currentIndex] = true
+		}
+	}
+
+	for i, execution := range current {
+		if !matched[i] {
+			err = keycloakClient.DeleteAuthenticationExecution(ctx, realmId, execution.Id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, execution := range executions {
+		if matches[i] == -1 || execution.SubFlow == nil {
+			continue
+		}
+
+		err = keycloakClient.deleteUnmatchedAuthenticationFlowTreeExecutions(ctx, realmId, current[matches[i]].SubFlow.Alias, execution.SubFlow.Executions)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileAuthenticationFlowTreeLevel creates or updates the executions of a flow, moves them into the wanted order,
+// then does the same within their subflows. The unmatched executions must already have been deleted.
+func (keycloakClient *KeycloakClient) reconcileAuthenticationFlowTreeLevel(ctx context.Context, realmId, flowAlias string, executions []*AuthenticationFlowTreeExecution) error {
+	current, err := keycloakClient.listAuthenticationFlowTreeLevel(ctx, realmId, flowAlias)
+	if err != nil {
+		return err
+	}
+
+	matches := matchAuthenticationFlowTreeExecutions(current, executions)
+
+	for i, execution := range executions {
+		var existing *AuthenticationFlowTreeExecution
+		if matches[i] != -1 {
+			existing = current[matches[i]]
+		}
+
+		err = keycloakClient.reconcileAuthenticationFlowTreeExecution(ctx, realmId, flowAlias, execution, existing)
+		if err != nil {
+			return err
+		}
+	}
+
+	current, err = keycloakClient.listAuthenticationFlowTreeLevel(ctx, realmId, flowAlias)
+	if err != nil {
+		return err
+	}
+
+	var currentIds, wantedIds []string
+	for _, execution := range current {
+		currentIds = append(currentIds, execution.Id)
+	}
+	for _, execution := range executions {
+		wantedIds = append(wantedIds, execution.Id)
+	}
+
+	for _, id := range authenticationFlowTreeRaises(currentIds, wantedIds) {
+		err = keycloakClient.RaiseAuthenticationExecutionPriority(ctx, realmId, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, execution := range executions {
+		if execution.SubFlow == nil {
+			continue
+		}
+
+		err = keycloakClient.reconcileAuthenticationFlowTreeLevel(ctx, realmId, execution.SubFlow.Alias, execution.SubFlow.Executions)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileAuthenticationFlowTreeExecution creates the wanted execution when there is no existing one, or updates the
+// existing one when its requirement, description or config changed
+func (keycloakClient *KeycloakClient) reconcileAuthenticationFlowTreeExecution(ctx context.Context, realmId, flowAlias string, execution, existing *AuthenticationFlowTreeExecution) error {
+	switch {
+	case existing == nil && execution.SubFlow != nil:
+		subFlow := &AuthenticationSubFlow{
+			RealmId:         realmId,
+			ParentFlowAlias: flowAlias,
+			Alias:           execution.SubFlow.Alias,
+			ProviderId:      execution.SubFlow.ProviderId,
+			Description:     execution.SubFlow.Description,
+			Authenticator:   execution.Authenticator,
+			Requirement:     execution.Requirement,
+		}
+
+		err := keycloakClient.NewAuthenticationSubFlow(ctx, subFlow)
+		if err != nil {
+			return err
+		}
+
+		execution.SubFlow.Id = subFlow.Id
+		execution.Id, err = keycloakClient.getExecutionId(ctx, subFlow)
+		if err != nil {
+			return err
+		}
+	case existing == nil:
+		authenticationExecution := &AuthenticationExecution{
+			RealmId:         realmId,
+			ParentFlowAlias: flowAlias,
+			Authenticator:   execution.Authenticator,
+			Requirement:     execution.Requirement,
+		}
+
+		err := keycloakClient.NewAuthenticationExecution(ctx, authenticationExecution)
+		if err != nil {
+			return err
+		}
+
+		execution.Id = authenticationExecution.Id
+	case execution.SubFlow != nil:
+		execution.Id = existing.Id
+		execution.SubFlow.Id = existing.SubFlow.Id
+
+		if execution.Requirement != existing.Requirement || execution.SubFlow.Description != existing.SubFlow.Description {
+			err := keycloakClient.UpdateAuthenticationSubFlow(ctx, &AuthenticationSubFlow{
+				Id:              existing.SubFlow.Id,
+				RealmId:         realmId,
+				ParentFlowAlias: flowAlias,
+				Alias:           execution.SubFlow.Alias,
+				ProviderId:      execution.SubFlow.ProviderId,
+				Description:     execution.SubFlow.Description,
+				Authenticator:   execution.Authenticator,
+				Requirement:     execution.Requirement,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		execution.Id = existing.Id
+
+		if execution.Requirement != existing.Requirement {
+			err := keycloakClient.UpdateAuthenticationExecutionRequirement(ctx, &authenticationExecutionRequirementUpdate{
+				RealmId:         realmId,
+				ParentFlowAlias: flowAlias,
+				Id:              existing.Id,
+				Requirement:     execution.Requirement,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var existingConfig *AuthenticationExecutionConfig
+	if existing != nil {
+		existingConfig = existing.Config
+	}
+
+	return keycloakClient.reconcileAuthenticationFlowTreeConfig(ctx, realmId, execution, existingConfig)
+}
+
+func (keycloakClient *KeycloakClient) reconcileAuthenticationFlowTreeConfig(ctx context.Context, realmId string, execution *AuthenticationFlowTreeExecution, existing *AuthenticationExecutionConfig) error {
+	if execution.Config == nil {
+		if existing == nil {
+			return nil
+		}
+
+		return keycloakClient.DeleteAuthenticationExecutionConfig(ctx, existing)
+	}
+
+	execution.Config.RealmId = realmId
+	execution.Config.ExecutionId = execution.Id
+
+	if existing == nil {
+		id, err := keycloakClient.NewAuthenticationExecutionConfig(ctx, execution.Config)
+		if err != nil {
+			return err
+		}
+
+		execution.Config.Id = id
+
+		return nil
+	}
+
+	execution.Config.Id = existing.Id
+	if execution.Config.Alias == existing.Alias && maps.Equal(execution.Config.Config, existing.Config) {
+		return nil
+	}
+
+	return keycloakClient.UpdateAuthenticationExecutionConfig(ctx, execution.Config)
+}
+
+// matchAuthenticationFlowTreeExecutions returns, for each wanted execution, the index of the existing execution it
+// matches, or -1 when it has to be created. Executions running the same authenticator are matched in their order.
+func matchAuthenticationFlowTreeExecutions(current, wanted []*AuthenticationFlowTreeExecution) []int {
+	currentIndexesByKey := map[string][]int{}
+	for i, execution := range current {
+		key := execution.key()
+		currentIndexesByKey[key] = append(currentIndexesByKey[key], i)
+	}
+
+	matches := make([]int, len(wanted))
+	for i, execution := range wanted {
+		key := execution.key()
+
+		if currentIndexes := currentIndexesByKey[key]; len(currentIndexes) != 0 {
+			matches[i] = currentIndexes[0]
+			currentIndexesByKey[key] = currentIndexes[1:]
+		} else {
+			matches[i] = -1
+		}
+	}
+
+	return matches
+}
+
+// authenticationFlowTreeRaises returns the executions to raise, one position at a time, to go from the current order
+// of the executions of a flow to the wanted one. Executions which are already in place are not moved.
+func authenticationFlowTreeRaises(currentIds, wantedIds []string) []string {
+	ids := append([]string{}, currentIds...)
+
+	var raises []string
+	for i, id := range wantedIds {
+		position := -1
+		for j := i; j < len(ids); j++ {
+			if ids[j] == id {
+				position = j
+				break
+			}
+		}
+
+		for ; position > i; position-- {
+			ids[position-1], ids[position] = ids[position], ids[position-1]
+			raises = append(raises, id)
+		}
+	}
+
+	return raises
+}
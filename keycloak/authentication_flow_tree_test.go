@@ -0,0 +1,48 @@
+package keycloak
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatchAuthenticationFlowTreeExecutions(t *testing.T) {
+	current := []*AuthenticationFlowTreeExecution{
+		{Id: "cookie", Authenticator: "auth-cookie"},
+		{Id: "forms", SubFlow: &AuthenticationFlowTreeSubFlow{Alias: "forms", ProviderId: "basic-flow"}},
+		{Id: "condition-1", Authenticator: "conditional-user-configured"},
+		{Id: "condition-2", Authenticator: "conditional-user-configured"},
+	}
+
+	wanted := []*AuthenticationFlowTreeExecution{
+		{Authenticator: "conditional-user-configured"},
+		{Authenticator: "auth-cookie"},
+		{Authenticator: "conditional-user-configured"},
+		{Authenticator: "conditional-user-configured"},
+		{SubFlow: &AuthenticationFlowTreeSubFlow{Alias: "forms", ProviderId: "form-flow"}},
+	}
+
+	matches := matchAuthenticationFlowTreeExecutions(current, wanted)
+
+	// the subflow changed type, so it can't be kept
+	expected := []int{2, 0, 3, -1, -1}
+	if !reflect.DeepEqual(expected, matches) {
+		t.Fatalf("expected matches %v, got %v", expected, matches)
+	}
+}
+
+func TestAuthenticationFlowTreeRaises(t *testing.T) {
+	raises := authenticationFlowTreeRaises([]string{"a", "b", "c"}, []string{"a", "b", "c"})
+	if len(raises) != 0 {
+		t.Fatalf("expected no raises when the executions are in order, got %v", raises)
+	}
+
+	raises = authenticationFlowTreeRaises([]string{"a", "b", "c", "d"}, []string{"a", "d", "b", "c"})
+	if !reflect.DeepEqual([]string{"d", "d"}, raises) {
+		t.Fatalf("expected d to be raised twice, got %v", raises)
+	}
+
+	raises = authenticationFlowTreeRaises([]string{"a", "b", "c"}, []string{"c", "b", "a"})
+	if !reflect.DeepEqual([]string{"c", "c", "b"}, raises) {
+		t.Fatalf("expected the executions to be reversed, got %v", raises)
+	}
+}
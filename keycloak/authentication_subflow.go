@@ -72,6 +72,23 @@ func (keycloakClient *KeycloakClient) GetAuthenticationSubFlow(ctx context.Conte
 	return &authenticationSubFlow, nil
 }
 
+// GetAuthenticationSubFlowIdFromAlias finds the flow id of a subflow from its alias. Subflows are not returned by
+// the flows endpoint, so they are looked up through the executions of their parent flow.
+func (keycloakClient *KeycloakClient) GetAuthenticationSubFlowIdFromAlias(ctx context.Context, realmId, parentFlowAlias, alias string) (string, error) {
+	list, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return "", err
+	}
+
+	for _, ex := range list {
+		if ex.AuthenticationFlow && ex.DisplayName == alias {
+			return ex.FlowId, nil
+		}
+	}
+
+	return "", fmt.Errorf("no subflow with alias %s found under parent flow alias %s", alias, parentFlowAlias)
+}
+
 func (keycloakClient *KeycloakClient) getExecutionId(ctx context.Context, authenticationSubFlow *AuthenticationSubFlow) (string, error) {
 	list, err := keycloakClient.ListAuthenticationExecutions(ctx, authenticationSubFlow.RealmId, authenticationSubFlow.ParentFlowAlias)
 	if err != nil {
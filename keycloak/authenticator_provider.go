@@ -0,0 +1,104 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// AuthenticatorProvider is an authenticator, form action or client authenticator which can run in an execution of an
+// authentication flow
+type AuthenticatorProvider struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+}
+
+// AuthenticatorConfigDescription https://www.keycloak.org/docs-api/latest/rest-api/index.html#AuthenticatorConfigInfoRepresentation
+type AuthenticatorConfigDescription struct {
+	ProviderId string                         `json:"providerId"`
+	Name       string                         `json:"name"`
+	HelpText   string                         `json:"helpText"`
+	Properties []*AuthenticatorConfigProperty `json:"properties"`
+}
+
+// AuthenticatorConfigProperty https://www.keycloak.org/docs-api/latest/rest-api/index.html#ConfigPropertyRepresentation
+type AuthenticatorConfigProperty struct {
+	Name         string      `json:"name"`
+	Label        string      `json:"label"`
+	HelpText     string      `json:"helpText"`
+	Type         string      `json:"type"`
+	DefaultValue interface{} `json:"defaultValue"` // a string, a boolean or a list depending on the type
+	Options      []string    `json:"options"`
+	Secret       bool        `json:"secret"`
+	ReadOnly     bool        `json:"readOnly"`
+	Required     bool        `json:"required"`
+}
+
+func (keycloakClient *KeycloakClient) listAuthenticatorProviders(ctx context.Context, realmId, providerType string) ([]*AuthenticatorProvider, error) {
+	var providers []*AuthenticatorProvider
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/%s", realmId, providerType), &providers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return providers, nil
+}
+
+func (keycloakClient *KeycloakClient) ListAuthenticatorProviders(ctx context.Context, realmId string) ([]*AuthenticatorProvider, error) {
+	return keycloakClient.listAuthenticatorProviders(ctx, realmId, "authenticator-providers")
+}
+
+func (keycloakClient *KeycloakClient) ListFormActionProviders(ctx context.Context, realmId string) ([]*AuthenticatorProvider, error) {
+	return keycloakClient.listAuthenticatorProviders(ctx, realmId, "form-action-providers")
+}
+
+func (keycloakClient *KeycloakClient) ListClientAuthenticatorProviders(ctx context.Context, realmId string) ([]*AuthenticatorProvider, error) {
+	return keycloakClient.listAuthenticatorProviders(ctx, realmId, "client-authenticator-providers")
+}
+
+// GetAuthenticatorConfigDescription returns the config properties supported by an authenticator, form action or client
+// authenticator. Keycloak returns a 404 for unknown providers.
+func (keycloakClient *KeycloakClient) GetAuthenticatorConfigDescription(ctx context.Context, realmId, providerId string) (*AuthenticatorConfigDescription, error) {
+	var configDescription AuthenticatorConfigDescription
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/config-description/%s", realmId, url.PathEscape(providerId)), &configDescription, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &configDescription, nil
+}
+
+// UnknownProperties returns the given config keys which aren't properties of the config description, sorted
+func (configDescription *AuthenticatorConfigDescription) UnknownProperties(keys []string) []string {
+	properties := map[string]bool{}
+	for _, property := range configDescription.Properties {
+		properties[property.Name] = true
+	}
+
+	var unknownProperties []string
+	for _, key := range keys {
+		if !properties[key] {
+			unknownProperties = append(unknownProperties, key)
+		}
+	}
+
+	sort.Strings(unknownProperties)
+
+	return unknownProperties
+}
+
+// PropertyNames returns the names of the properties of the config description, sorted
+func (configDescription *AuthenticatorConfigDescription) PropertyNames() []string {
+	var names []string
+	for _, property := range configDescription.Properties {
+		names = append(names, property.Name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,25 @@
+package keycloak
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAuthenticatorConfigDescriptionUnknownProperties(t *testing.T) {
+	configDescription := &AuthenticatorConfigDescription{
+		ProviderId: "identity-provider-redirector",
+		Properties: []*AuthenticatorConfigProperty{
+			{Name: "defaultProvider"},
+		},
+	}
+
+	unknownProperties := configDescription.UnknownProperties([]string{"defaultProvider"})
+	if len(unknownProperties) != 0 {
+		t.Fatalf("expected no unknown properties, got %v", unknownProperties)
+	}
+
+	unknownProperties = configDescription.UnknownProperties([]string{"provider", "defaultProvider", "default"})
+	if !reflect.DeepEqual([]string{"default", "provider"}, unknownProperties) {
+		t.Fatalf("expected default and provider to be unknown, got %v", unknownProperties)
+	}
+}
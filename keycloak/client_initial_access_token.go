@@ -0,0 +1,56 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ClientInitialAccessToken allows to register clients through the dynamic client registration. Keycloak only
+// returns its token when it is created.
+type ClientInitialAccessToken struct {
+	Id             string `json:"id,omitempty"`
+	RealmId        string `json:"-"`
+	Token          string `json:"token,omitempty"`
+	Timestamp      int    `json:"timestamp,omitempty"`
+	Expiration     int    `json:"expiration"`
+	Count          int    `json:"count"`
+	RemainingCount int    `json:"remainingCount,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) NewClientInitialAccessToken(ctx context.Context, token *ClientInitialAccessToken) error {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients-initial-access", token.RealmId), token)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, token)
+}
+
+// GetClientInitialAccessToken finds a token among the tokens of the realm, as Keycloak cannot get a single one. The
+// returned token has no value.
+func (keycloakClient *KeycloakClient) GetClientInitialAccessToken(ctx context.Context, realmId, id string) (*ClientInitialAccessToken, error) {
+	var tokens []*ClientInitialAccessToken
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients-initial-access", realmId), &tokens, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens {
+		if token.Id == id {
+			token.RealmId = realmId
+
+			return token, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    404,
+		Message: fmt.Sprintf("client initial access token %s not found in realm %s", id, realmId),
+	}
+}
+
+func (keycloakClient *KeycloakClient) DeleteClientInitialAccessToken(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients-initial-access/%s", realmId, id), nil)
+}
@@ -0,0 +1,158 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+const (
+	ClientRegistrationPolicyProviderType = "org.keycloak.services.clientregistration.policy.ClientRegistrationPolicy"
+
+	ClientRegistrationPolicyAnonymous     = "anonymous"
+	ClientRegistrationPolicyAuthenticated = "authenticated"
+
+	ClientRegistrationPolicyTrustedHosts           = "trusted-hosts"
+	ClientRegistrationPolicyAllowedClientScopes    = "allowed-client-templates"
+	ClientRegistrationPolicyAllowedProtocolMappers = "allowed-protocol-mappers"
+	ClientRegistrationPolicyMaxClients             = "max-clients"
+	ClientRegistrationPolicyConsentRequired        = "consent-required"
+	ClientRegistrationPolicyFullScopeDisabled      = "scope"
+	ClientRegistrationPolicyClientDisabled         = "client-disabled"
+)
+
+// ClientRegistrationPolicy is a policy applied to the clients registered through the dynamic client registration,
+// either anonymously or with an initial access token or a bearer token (authenticated). Only the config of its
+// provider is sent to Keycloak.
+type ClientRegistrationPolicy struct {
+	Id         string
+	RealmId    string
+	ParentId   string
+	Name       string
+	ProviderId string
+	SubType    string
+
+	// trusted-hosts
+	TrustedHosts                            []string
+	HostSendingRegistrationRequestMustMatch bool
+	ClientUrisMustMatch                     bool
+
+	// allowed-client-templates
+	AllowedClientScopes []string
+	AllowDefaultScopes  bool
+
+	// allowed-protocol-mappers
+	AllowedProtocolMapperTypes []string
+
+	// max-clients
+	MaxClients int
+}
+
+func convertFromClientRegistrationPolicyToComponent(policy *ClientRegistrationPolicy) *Component {
+	config := map[string][]string{}
+
+	switch policy.ProviderId {
+	case ClientRegistrationPolicyTrustedHosts:
+		if len(policy.TrustedHosts) != 0 {
+			config["trusted-hosts"] = policy.TrustedHosts
+		}
+		config["host-sending-registration-request-must-match"] = []string{strconv.FormatBool(policy.HostSendingRegistrationRequestMustMatch)}
+		config["client-uris-must-match"] = []string{strconv.FormatBool(policy.ClientUrisMustMatch)}
+	case ClientRegistrationPolicyAllowedClientScopes:
+		if len(policy.AllowedClientScopes) != 0 {
+			config["allowed-client-scopes"] = policy.AllowedClientScopes
+		}
+		config["allow-default-scopes"] = []string{strconv.FormatBool(policy.AllowDefaultScopes)}
+	case ClientRegistrationPolicyAllowedProtocolMappers:
+		if len(policy.AllowedProtocolMapperTypes) != 0 {
+			config["allowed-protocol-mapper-types"] = policy.AllowedProtocolMapperTypes
+		}
+	case ClientRegistrationPolicyMaxClients:
+		config["max-clients"] = []string{strconv.Itoa(policy.MaxClients)}
+	}
+
+	return &Component{
+		Id:           policy.Id,
+		RealmId:      policy.RealmId,
+		Name:         policy.Name,
+		ProviderId:   policy.ProviderId,
+		ProviderType: ClientRegistrationPolicyProviderType,
+		ParentId:     policy.ParentId,
+		SubType:      policy.SubType,
+		Config:       config,
+	}
+}
+
+func convertFromComponentToClientRegistrationPolicy(component *Component) (*ClientRegistrationPolicy, error) {
+	policy := &ClientRegistrationPolicy{
+		Id:         component.Id,
+		RealmId:    component.RealmId,
+		ParentId:   component.ParentId,
+		Name:       component.Name,
+		ProviderId: component.ProviderId,
+		SubType:    component.SubType,
+
+		TrustedHosts:               component.Config["trusted-hosts"],
+		AllowedClientScopes:        component.Config["allowed-client-scopes"],
+		AllowedProtocolMapperTypes: component.Config["allowed-protocol-mapper-types"],
+	}
+
+	var err error
+	for key, value := range map[string]*bool{
+		"host-sending-registration-request-must-match": &policy.HostSendingRegistrationRequestMustMatch,
+		"client-uris-must-match":                       &policy.ClientUrisMustMatch,
+		"allow-default-scopes":                         &policy.AllowDefaultScopes,
+	} {
+		// Keycloak treats a missing value as true
+		*value = true
+		if values := component.Config[key]; len(values) != 0 && values[0] != "" {
+			*value, err = strconv.ParseBool(values[0])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if values := component.Config["max-clients"]; len(values) != 0 {
+		policy.MaxClients, err = atoiAndTreatEmptyStringAsZero(values[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return policy, nil
+}
+
+func (keycloakClient *KeycloakClient) NewClientRegistrationPolicy(ctx context.Context, policy *ClientRegistrationPolicy) error {
+	component := convertFromClientRegistrationPolicyToComponent(policy)
+
+	err := keycloakClient.NewComponent(ctx, component)
+	if err != nil {
+		return err
+	}
+
+	policy.Id = component.Id
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetClientRegistrationPolicy(ctx context.Context, realmId, id string) (*ClientRegistrationPolicy, error) {
+	component, err := keycloakClient.GetComponent(ctx, realmId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if component.ProviderType != ClientRegistrationPolicyProviderType {
+		return nil, fmt.Errorf("component %s is not a client registration policy", id)
+	}
+
+	return convertFromComponentToClientRegistrationPolicy(component)
+}
+
+func (keycloakClient *KeycloakClient) UpdateClientRegistrationPolicy(ctx context.Context, policy *ClientRegistrationPolicy) error {
+	return keycloakClient.UpdateComponent(ctx, convertFromClientRegistrationPolicyToComponent(policy))
+}
+
+func (keycloakClient *KeycloakClient) DeleteClientRegistrationPolicy(ctx context.Context, realmId, id string) error {
+	return keycloakClient.DeleteComponent(ctx, realmId, id)
+}
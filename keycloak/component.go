@@ -16,6 +16,18 @@ type component struct {
 	Config       map[string][]string `json:"config"`
 }
 
+// Component is a component of any provider type, its config is left untyped
+type Component struct {
+	Id           string              `json:"id,omitempty"`
+	RealmId      string              `json:"-"`
+	Name         string              `json:"name"`
+	ProviderId   string              `json:"providerId"`
+	ProviderType string              `json:"providerType"`
+	ParentId     string              `json:"parentId"`
+	SubType      string              `json:"subType,omitempty"`
+	Config       map[string][]string `json:"config"`
+}
+
 func (component *component) getConfig(val string) string {
 	if len(component.Config[val]) == 0 {
 		return ""
@@ -32,6 +44,86 @@ func (component *component) getConfigOk(val string) (string, bool) {
 	return "", false
 }
 
+// GetComponentIdByName finds the id of a component from its name. Components are only unique by name within
+// a given parent and provider type, so both are used to narrow down the search. parentId can be left empty
+// for components that are directly attached to the realm.
+func (keycloakClient *KeycloakClient) GetComponentIdByName(ctx context.Context, realmId, parentId, providerType, name string) (string, error) {
+	var components []*component
+
+	params := map[string]string{
+		"type": providerType,
+		"name": name,
+	}
+	if parentId != "" {
+		params["parent"] = parentId
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components", realmId), &components, params)
+	if err != nil {
+		return "", err
+	}
+
+	for _, component := range components {
+		if component.Name == name {
+			return component.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("no component of type %s with name %s found in realm %s", providerType, name, realmId)
+}
+
+// ListComponents lists the components of a given provider type. parentId can be left empty to list the components of
+// the whole realm.
+func (keycloakClient *KeycloakClient) ListComponents(ctx context.Context, realmId, parentId, providerType string) ([]*Component, error) {
+	var components []*Component
+
+	params := map[string]string{
+		"type": providerType,
+	}
+	if parentId != "" {
+		params["parent"] = parentId
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components", realmId), &components, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, component := range components {
+		component.RealmId = realmId
+	}
+
+	return components, nil
+}
+
+func (keycloakClient *KeycloakClient) NewComponent(ctx context.Context, component *Component) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", component.RealmId), component)
+	if err != nil {
+		return err
+	}
+
+	component.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetComponent(ctx context.Context, realmId, id string) (*Component, error) {
+	var component *Component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	component.RealmId = realmId
+
+	return component, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateComponent(ctx context.Context, component *Component) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", component.RealmId, component.Id), component)
+}
+
 func (keycloakClient *KeycloakClient) DeleteComponent(ctx context.Context, realmId, id string) error {
 	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), nil)
 }
@@ -0,0 +1,33 @@
+package keycloak
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCustomUserFederationMultiValueConfig(t *testing.T) {
+	custom := &CustomUserFederation{
+		Name:        "custom",
+		RealmId:     "realm",
+		ProviderId:  "custom-provider",
+		CachePolicy: "DEFAULT",
+		Config: map[string][]string{
+			"hosts":  {"a.example.com", "b.example.com"},
+			"single": {"value"},
+		},
+	}
+
+	component := convertFromCustomUserFederationToComponent(custom)
+	if !reflect.DeepEqual(component.Config["hosts"], []string{"a.example.com", "b.example.com"}) {
+		t.Fatalf("expected all the values of the hosts config to be sent, got %v", component.Config["hosts"])
+	}
+
+	converted, err := convertFromComponentToCustomUserFederation(component, "realm")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(converted.Config, custom.Config) {
+		t.Fatalf("expected config %v, got %v", custom.Config, converted.Config)
+	}
+}
@@ -54,6 +54,24 @@ func (keycloakClient *KeycloakClient) GetGenericProtocolMappers(ctx context.Cont
 
 }
 
+// ListGenericProtocolMappers lists the protocol mappers attached to a client or a client scope
+func (keycloakClient *KeycloakClient) ListGenericProtocolMappers(ctx context.Context, realmId, clientId, clientScopeId string) ([]*GenericProtocolMapper, error) {
+	var protocolMappers []*GenericProtocolMapper
+
+	err := keycloakClient.get(ctx, protocolMapperPath(realmId, clientId, clientScopeId), &protocolMappers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		protocolMapper.RealmId = realmId
+		protocolMapper.ClientId = clientId
+		protocolMapper.ClientScopeId = clientScopeId
+	}
+
+	return protocolMappers, nil
+}
+
 func (keycloakClient *KeycloakClient) GetGenericProtocolMapper(ctx context.Context, realmId string, clientId string, clientScopeId string, mapperId string) (*GenericProtocolMapper, error) {
 	var genericProtocolMapper GenericProtocolMapper
 
@@ -138,6 +138,31 @@ func (keycloakClient *KeycloakClient) GetGroup(ctx context.Context, realmId, id
 	return &group, nil
 }
 
+// GetGroupByPath looks up a group using its full path, such as /parent/child
+func (keycloakClient *KeycloakClient) GetGroupByPath(ctx context.Context, realmId, path string) (*Group, error) {
+	var group Group
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/group-by-path%s", realmId, path), &group, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	group.RealmId = realmId
+
+	parentId, err := keycloakClient.groupParentId(ctx, &group)
+	if err != nil {
+		return nil, err
+	}
+
+	group.ParentId = parentId
+
+	return &group, nil
+}
+
 func (keycloakClient *KeycloakClient) GetGroupByName(ctx context.Context, realmId, name string) (*Group, error) {
 	var groups []Group
 
@@ -98,6 +98,35 @@ func (keycloakClient *KeycloakClient) GetIdentityProvider(ctx context.Context, r
 	return &identityProvider, nil
 }
 
+func (keycloakClient *KeycloakClient) ListIdentityProviders(ctx context.Context, realm string) ([]*IdentityProvider, error) {
+	var identityProviders []*IdentityProvider
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances", realm), &identityProviders, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, identityProvider := range identityProviders {
+		identityProvider.Realm = realm
+	}
+
+	return identityProviders, nil
+}
+
+// ValidateSocialIdentityProvider checks that a social identity provider, such as github or microsoft, is installed on the server
+func (keycloakClient *KeycloakClient) ValidateSocialIdentityProvider(ctx context.Context, providerId string) error {
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !serverInfo.providerInstalled("social", providerId) {
+		return fmt.Errorf("validation error: social identity provider \"%s\" does not exist on the server, installed providers: %s", providerId, serverInfo.getInstalledProvidersNames("social"))
+	}
+
+	return nil
+}
+
 func (keycloakClient *KeycloakClient) UpdateIdentityProvider(ctx context.Context, identityProvider *IdentityProvider) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s", identityProvider.Realm, identityProvider.Alias), identityProvider)
 }
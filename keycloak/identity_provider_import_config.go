@@ -0,0 +1,66 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// IdentityProviderImportConfig holds the settings Keycloak parses from the SAML metadata or the OpenID Connect discovery
+// document of an identity provider, keyed like the config of an identity provider
+type IdentityProviderImportConfig map[string]string
+
+type identityProviderImportConfigRequest struct {
+	ProviderId string `json:"providerId"`
+	FromUrl    string `json:"fromUrl"`
+}
+
+// ImportIdentityProviderConfigFromUrl has Keycloak fetch and parse the metadata or discovery document available at fromUrl
+func (keycloakClient *KeycloakClient) ImportIdentityProviderConfigFromUrl(ctx context.Context, realm, providerId, fromUrl string) (IdentityProviderImportConfig, error) {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/identity-provider/import-config", realm), &identityProviderImportConfigRequest{
+		ProviderId: providerId,
+		FromUrl:    fromUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalIdentityProviderImportConfig(body)
+}
+
+// ImportIdentityProviderConfigFromDocument has Keycloak parse a metadata or discovery document, uploaded as a file
+func (keycloakClient *KeycloakClient) ImportIdentityProviderConfigFromDocument(ctx context.Context, realm, providerId, document string) (IdentityProviderImportConfig, error) {
+	body, err := keycloakClient.postMultipart(ctx, fmt.Sprintf("/realms/%s/identity-provider/import-config", realm), map[string]string{
+		"providerId": providerId,
+	}, map[string][]byte{
+		"file": []byte(document),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalIdentityProviderImportConfig(body)
+}
+
+func unmarshalIdentityProviderImportConfig(body []byte) (IdentityProviderImportConfig, error) {
+	// values are expected to be strings, anything else is formatted rather than rejected
+	var rawConfig map[string]interface{}
+	if err := json.Unmarshal(body, &rawConfig); err != nil {
+		return nil, err
+	}
+
+	config := IdentityProviderImportConfig{}
+	for key, value := range rawConfig {
+		if value == nil {
+			continue
+		}
+
+		if s, ok := value.(string); ok {
+			config[key] = s
+		} else {
+			config[key] = fmt.Sprint(value)
+		}
+	}
+
+	return config, nil
+}
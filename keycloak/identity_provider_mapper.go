@@ -2,6 +2,7 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 )
@@ -22,6 +23,13 @@ type IdentityProviderMapperConfig struct {
 	ExtraConfig           map[string]interface{} `json:"-"`
 }
 
+// IdentityProviderMapperKeyValue is an entry of the claims or attributes matched by the advanced identity provider mappers,
+// which Keycloak expects as a JSON-encoded list within the mapper config
+type IdentityProviderMapperKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type IdentityProviderMapper struct {
 	Realm                  string                        `json:"-"`
 	Provider               string                        `json:"-"`
@@ -67,6 +75,24 @@ func (keycloakClient *KeycloakClient) GetIdentityProviderMapper(ctx context.Cont
 	return &identityProviderMapper, nil
 }
 
+func (keycloakClient *KeycloakClient) GetIdentityProviderMapperByName(ctx context.Context, realm, alias, name string) (*IdentityProviderMapper, error) {
+	identityProviderMappers, err := keycloakClient.GetIdentityProviderMappers(ctx, realm, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, identityProviderMapper := range identityProviderMappers {
+		if identityProviderMapper.Name == name {
+			identityProviderMapper.Realm = realm
+			identityProviderMapper.IdentityProviderAlias = alias
+
+			return identityProviderMapper, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no mapper with name %s found for identity provider %s", name, alias)
+}
+
 func (keycloakClient *KeycloakClient) UpdateIdentityProviderMapper(ctx context.Context, identityProviderMapper *IdentityProviderMapper) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/identity-provider/instances/%s/mappers/%s", identityProviderMapper.Realm, identityProviderMapper.IdentityProviderAlias, identityProviderMapper.Id), identityProviderMapper)
 }
@@ -82,3 +108,34 @@ func (f *IdentityProviderMapperConfig) UnmarshalJSON(data []byte) error {
 func (f *IdentityProviderMapperConfig) MarshalJSON() ([]byte, error) {
 	return marshalExtraConfig(reflect.ValueOf(f).Elem(), f.ExtraConfig)
 }
+
+// GetKeyValues decodes the JSON-encoded list of claims or attributes stored under the given config key
+func (f *IdentityProviderMapperConfig) GetKeyValues(key string) ([]IdentityProviderMapperKeyValue, error) {
+	keyValues := []IdentityProviderMapperKeyValue{}
+
+	value, ok := f.ExtraConfig[key].(string)
+	if !ok || value == "" {
+		return keyValues, nil
+	}
+
+	if err := json.Unmarshal([]byte(value), &keyValues); err != nil {
+		return nil, fmt.Errorf("unable to parse %s of identity provider mapper: %w", key, err)
+	}
+
+	return keyValues, nil
+}
+
+// SetKeyValues encodes the list of claims or attributes under the given config key
+func (f *IdentityProviderMapperConfig) SetKeyValues(key string, keyValues []IdentityProviderMapperKeyValue) error {
+	value, err := json.Marshal(keyValues)
+	if err != nil {
+		return err
+	}
+
+	if f.ExtraConfig == nil {
+		f.ExtraConfig = map[string]interface{}{}
+	}
+	f.ExtraConfig[key] = string(value)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestIdentityProviderMapperConfigKeyValues(t *testing.T) {
+	claims := []IdentityProviderMapperKeyValue{
+		{Key: "groups", Value: "admins|operators"},
+		{Key: "department", Value: "it"},
+	}
+
+	config := &IdentityProviderMapperConfig{Role: "admin"}
+	if err := config.SetKeyValues("claims", claims); err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rawConfig map[string]interface{}
+	if err = json.Unmarshal(body, &rawConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := rawConfig["claims"].(string); !ok {
+		t.Fatalf("expected claims to be sent as a JSON-encoded string, got %v", rawConfig["claims"])
+	}
+
+	var parsedConfig IdentityProviderMapperConfig
+	if err = json.Unmarshal(body, &parsedConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	parsedClaims, err := parsedConfig.GetKeyValues("claims")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(claims, parsedClaims) {
+		t.Fatalf("expected claims %v, got %v", claims, parsedClaims)
+	}
+
+	missingAttributes, err := parsedConfig.GetKeyValues("attributes")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(missingAttributes) != 0 {
+		t.Fatalf("expected no attributes, got %v", missingAttributes)
+	}
+}
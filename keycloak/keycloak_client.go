@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -478,6 +479,56 @@ func (keycloakClient *KeycloakClient) post(ctx context.Context, path string, req
 	return body, location, err
 }
 
+// postForm sends a form, for the few endpoints of the admin API that do not accept JSON
+func (keycloakClient *KeycloakClient) postForm(ctx context.Context, path string, form url.Values) error {
+	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, resourceUrl, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-type", "application/x-www-form-urlencoded")
+
+	_, _, err = keycloakClient.sendRequest(ctx, request, []byte(form.Encode()))
+
+	return err
+}
+
+// postMultipart sends a multipart form, for the endpoints of the admin API which take uploaded files
+func (keycloakClient *KeycloakClient) postMultipart(ctx context.Context, path string, fields map[string]string, files map[string][]byte) ([]byte, error) {
+	resourceUrl := keycloakClient.baseUrl + apiUrl + path
+
+	var payload bytes.Buffer
+	writer := multipart.NewWriter(&payload)
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return nil, err
+		}
+	}
+	for name, content := range files {
+		part, err := writer.CreateFormFile(name, name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, resourceUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-type", writer.FormDataContentType())
+
+	body, _, err := keycloakClient.sendRequest(ctx, request, payload.Bytes())
+
+	return body, err
+}
+
 func (keycloakClient *KeycloakClient) put(ctx context.Context, path string, requestBody interface{}) error {
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
@@ -0,0 +1,80 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// LdapCertificateMapper maps a certificate stored in an LDAP attribute, so users can log in with X.509 client certificates.
+// It supports the options of the user attribute mapper.
+type LdapCertificateMapper struct {
+	LdapUserAttributeMapper
+
+	IsDerFormatted bool
+}
+
+func convertFromLdapCertificateMapperToComponent(ldapCertificateMapper *LdapCertificateMapper) *component {
+	component := convertFromLdapUserAttributeMapperToComponent(&ldapCertificateMapper.LdapUserAttributeMapper)
+
+	component.ProviderId = "certificate-ldap-mapper"
+	component.Config["is.der.formatted"] = []string{strconv.FormatBool(ldapCertificateMapper.IsDerFormatted)}
+
+	return component
+}
+
+func convertFromComponentToLdapCertificateMapper(component *component, realmId string) (*LdapCertificateMapper, error) {
+	ldapUserAttributeMapper, err := convertFromComponentToLdapUserAttributeMapper(component, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	isDerFormatted, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("is.der.formatted"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &LdapCertificateMapper{
+		LdapUserAttributeMapper: *ldapUserAttributeMapper,
+		IsDerFormatted:          isDerFormatted,
+	}, nil
+}
+
+// ValidateLdapCertificateMapper applies the validation Keycloak does on binary attributes, which are not cached
+func (keycloakClient *KeycloakClient) ValidateLdapCertificateMapper(ldapCertificateMapper *LdapCertificateMapper) error {
+	if ldapCertificateMapper.IsBinaryAttribute && !ldapCertificateMapper.AlwaysReadValueFromLdap {
+		return fmt.Errorf("validation error: always_read_value_from_ldap must be true when is_binary_attribute is true")
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) NewLdapCertificateMapper(ctx context.Context, ldapCertificateMapper *LdapCertificateMapper) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", ldapCertificateMapper.RealmId), convertFromLdapCertificateMapperToComponent(ldapCertificateMapper))
+	if err != nil {
+		return err
+	}
+
+	ldapCertificateMapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetLdapCertificateMapper(ctx context.Context, realmId, id string) (*LdapCertificateMapper, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToLdapCertificateMapper(component, realmId)
+}
+
+func (keycloakClient *KeycloakClient) UpdateLdapCertificateMapper(ctx context.Context, ldapCertificateMapper *LdapCertificateMapper) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", ldapCertificateMapper.RealmId, ldapCertificateMapper.Id), convertFromLdapCertificateMapperToComponent(ldapCertificateMapper))
+}
+
+func (keycloakClient *KeycloakClient) DeleteLdapCertificateMapper(ctx context.Context, realmId, id string) error {
+	return keycloakClient.DeleteComponent(ctx, realmId, id)
+}
@@ -0,0 +1,73 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+// LdapKerberosPrincipalAttributeMapper maps the LDAP attribute holding the kerberos principal of the users, used to
+// find them when they log in with SPNEGO
+type LdapKerberosPrincipalAttributeMapper struct {
+	Id                   string
+	Name                 string
+	RealmId              string
+	LdapUserFederationId string
+
+	LdapAttribute string
+}
+
+func convertFromLdapKerberosPrincipalAttributeMapperToComponent(ldapKerberosPrincipalAttributeMapper *LdapKerberosPrincipalAttributeMapper) *component {
+	return &component{
+		Id:           ldapKerberosPrincipalAttributeMapper.Id,
+		Name:         ldapKerberosPrincipalAttributeMapper.Name,
+		ProviderId:   "kerberos-principal-attribute-mapper",
+		ProviderType: "org.keycloak.storage.ldap.mappers.LDAPStorageMapper",
+		ParentId:     ldapKerberosPrincipalAttributeMapper.LdapUserFederationId,
+		Config: map[string][]string{
+			"ldap.attribute": {
+				ldapKerberosPrincipalAttributeMapper.LdapAttribute,
+			},
+		},
+	}
+}
+
+func convertFromComponentToLdapKerberosPrincipalAttributeMapper(component *component, realmId string) *LdapKerberosPrincipalAttributeMapper {
+	return &LdapKerberosPrincipalAttributeMapper{
+		Id:                   component.Id,
+		Name:                 component.Name,
+		RealmId:              realmId,
+		LdapUserFederationId: component.ParentId,
+
+		LdapAttribute: component.getConfig("ldap.attribute"),
+	}
+}
+
+func (keycloakClient *KeycloakClient) NewLdapKerberosPrincipalAttributeMapper(ctx context.Context, ldapKerberosPrincipalAttributeMapper *LdapKerberosPrincipalAttributeMapper) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", ldapKerberosPrincipalAttributeMapper.RealmId), convertFromLdapKerberosPrincipalAttributeMapperToComponent(ldapKerberosPrincipalAttributeMapper))
+	if err != nil {
+		return err
+	}
+
+	ldapKerberosPrincipalAttributeMapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetLdapKerberosPrincipalAttributeMapper(ctx context.Context, realmId, id string) (*LdapKerberosPrincipalAttributeMapper, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToLdapKerberosPrincipalAttributeMapper(component, realmId), nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateLdapKerberosPrincipalAttributeMapper(ctx context.Context, ldapKerberosPrincipalAttributeMapper *LdapKerberosPrincipalAttributeMapper) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", ldapKerberosPrincipalAttributeMapper.RealmId, ldapKerberosPrincipalAttributeMapper.Id), convertFromLdapKerberosPrincipalAttributeMapperToComponent(ldapKerberosPrincipalAttributeMapper))
+}
+
+func (keycloakClient *KeycloakClient) DeleteLdapKerberosPrincipalAttributeMapper(ctx context.Context, realmId, id string) error {
+	return keycloakClient.DeleteComponent(ctx, realmId, id)
+}
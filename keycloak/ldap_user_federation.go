@@ -514,6 +514,15 @@ func (keycloakClient *KeycloakClient) GetLdapUserFederationMappers(ctx context.C
 				return nil, err
 			}
 			ldapUserFederationMappers = append(ldapUserFederationMappers, mapper)
+		case "certificate-ldap-mapper":
+			mapper, err := convertFromComponentToLdapCertificateMapper(component, realmId)
+			if err != nil {
+				return nil, err
+			}
+			ldapUserFederationMappers = append(ldapUserFederationMappers, mapper)
+		case "kerberos-principal-attribute-mapper":
+			mapper := convertFromComponentToLdapKerberosPrincipalAttributeMapper(component, realmId)
+			ldapUserFederationMappers = append(ldapUserFederationMappers, mapper)
 		case "role-ldap-mapper":
 			mapper, err := convertFromComponentToLdapRoleMapper(component, realmId)
 			if err != nil {
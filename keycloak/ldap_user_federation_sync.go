@@ -0,0 +1,113 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	LdapUserFederationFullSync         = "triggerFullSync"
+	LdapUserFederationChangedUsersSync = "triggerChangedUsersSync"
+
+	LdapMapperSyncFederationToKeycloak = "fedToKeycloak"
+	LdapMapperSyncKeycloakToFederation = "keycloakToFed"
+)
+
+// SynchronizationResult is returned by the synchronization of a user federation or an LDAP mapper
+type SynchronizationResult struct {
+	Ignored bool   `json:"ignored"`
+	Added   int    `json:"added"`
+	Updated int    `json:"updated"`
+	Removed int    `json:"removed"`
+	Failed  int    `json:"failed"`
+	Status  string `json:"status"`
+}
+
+type ldapConnectionTest struct {
+	Action            string `json:"action"`
+	ConnectionUrl     string `json:"connectionUrl"`
+	AuthType          string `json:"authType"`
+	BindDn            string `json:"bindDn,omitempty"`
+	BindCredential    string `json:"bindCredential,omitempty"`
+	UseTruststoreSpi  string `json:"useTruststoreSpi"`
+	ConnectionTimeout string `json:"connectionTimeout,omitempty"`
+	StartTls          string `json:"startTls"`
+	ComponentId       string `json:"componentId,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) SyncLdapUserFederation(ctx context.Context, realmId, id, action string) (*SynchronizationResult, error) {
+	return keycloakClient.synchronize(ctx, fmt.Sprintf("/realms/%s/user-storage/%s/sync?action=%s", realmId, id, url.QueryEscape(action)))
+}
+
+func (keycloakClient *KeycloakClient) SyncLdapMapper(ctx context.Context, realmId, ldapUserFederationId, mapperId, direction string) (*SynchronizationResult, error) {
+	return keycloakClient.synchronize(ctx, fmt.Sprintf("/realms/%s/user-storage/%s/mappers/%s/sync?direction=%s", realmId, ldapUserFederationId, mapperId, url.QueryEscape(direction)))
+}
+
+func (keycloakClient *KeycloakClient) synchronize(ctx context.Context, path string) (*SynchronizationResult, error) {
+	body, _, err := keycloakClient.post(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SynchronizationResult
+	if len(body) != 0 {
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+	}
+
+	return &result, nil
+}
+
+// TestLdapUserFederationConnection checks that Keycloak can connect to the LDAP server of a user federation, and bind
+// with its credentials when it has a bind DN. The stored credential is used when the user federation exists and no
+// credential is given.
+func (keycloakClient *KeycloakClient) TestLdapUserFederationConnection(ctx context.Context, realmId string, ldap *LdapUserFederation) error {
+	connectionTest := &ldapConnectionTest{
+		Action:         "testConnection",
+		ConnectionUrl:  ldap.ConnectionUrl,
+		AuthType:       "none",
+		BindDn:         ldap.BindDn,
+		BindCredential: ldap.BindCredential,
+		StartTls:       strconv.FormatBool(ldap.StartTls),
+		ComponentId:    ldap.Id,
+	}
+
+	if ldap.BindDn != "" {
+		connectionTest.AuthType = "simple"
+	}
+
+	if ldap.UseTruststoreSpi == "ONLY_FOR_LDAPS" {
+		connectionTest.UseTruststoreSpi = "ldapsOnly"
+	} else {
+		connectionTest.UseTruststoreSpi = strings.ToLower(ldap.UseTruststoreSpi)
+	}
+
+	if ldap.ConnectionTimeout != "" {
+		connectionTimeoutMs, err := getMillisecondsFromDurationString(ldap.ConnectionTimeout)
+		if err != nil {
+			return err
+		}
+
+		connectionTest.ConnectionTimeout = connectionTimeoutMs
+	}
+
+	if _, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/testLDAPConnection", realmId), connectionTest); err != nil {
+		return fmt.Errorf("unable to connect to the LDAP server %s: %s", ldap.ConnectionUrl, err)
+	}
+
+	if connectionTest.AuthType == "none" {
+		return nil
+	}
+
+	connectionTest.Action = "testAuthentication"
+	if _, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/testLDAPConnection", realmId), connectionTest); err != nil {
+		return fmt.Errorf("unable to authenticate to the LDAP server %s as %s: %s", ldap.ConnectionUrl, ldap.BindDn, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type OpenIdAddressProtocolMapper struct {
+	Id            string
+	Name          string
+	RealmId       string
+	ClientId      string
+	ClientScopeId string
+
+	AddToIdToken            bool
+	AddToAccessToken        bool
+	AddToUserInfo           bool
+	AddToTokenIntrospection bool
+
+	FormattedAttribute  string
+	StreetAttribute     string
+	LocalityAttribute   string
+	RegionAttribute     string
+	PostalCodeAttribute string
+	CountryAttribute    string
+}
+
+func (mapper *OpenIdAddressProtocolMapper) convertToGenericProtocolMapper() *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "openid-connect",
+		ProtocolMapper: "oidc-address-mapper",
+		Config: map[string]string{
+			addToIdTokenField:               strconv.FormatBool(mapper.AddToIdToken),
+			addToAccessTokenField:           strconv.FormatBool(mapper.AddToAccessToken),
+			addToUserInfoField:              strconv.FormatBool(mapper.AddToUserInfo),
+			addToTokenIntrospectionField:    strconv.FormatBool(mapper.AddToTokenIntrospection),
+			addressFormattedAttributeField:  mapper.FormattedAttribute,
+			addressStreetAttributeField:     mapper.StreetAttribute,
+			addressLocalityAttributeField:   mapper.LocalityAttribute,
+			addressRegionAttributeField:     mapper.RegionAttribute,
+			addressPostalCodeAttributeField: mapper.PostalCodeAttribute,
+			addressCountryAttributeField:    mapper.CountryAttribute,
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToOpenIdAddressProtocolMapper(realmId, clientId, clientScopeId string) (*OpenIdAddressProtocolMapper, error) {
+	addToIdToken, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToIdTokenField])
+	if err != nil {
+		return nil, err
+	}
+
+	addToAccessToken, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToAccessTokenField])
+	if err != nil {
+		return nil, err
+	}
+
+	addToUserInfo, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToUserInfoField])
+	if err != nil {
+		return nil, err
+	}
+
+	addToTokenIntrospection, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[addToTokenIntrospectionField])
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenIdAddressProtocolMapper{
+		Id:            protocolMapper.Id,
+		Name:          protocolMapper.Name,
+		RealmId:       realmId,
+		ClientId:      clientId,
+		ClientScopeId: clientScopeId,
+
+		AddToIdToken:            addToIdToken,
+		AddToAccessToken:        addToAccessToken,
+		AddToUserInfo:           addToUserInfo,
+		AddToTokenIntrospection: addToTokenIntrospection,
+
+		FormattedAttribute:  protocolMapper.Config[addressFormattedAttributeField],
+		StreetAttribute:     protocolMapper.Config[addressStreetAttributeField],
+		LocalityAttribute:   protocolMapper.Config[addressLocalityAttributeField],
+		RegionAttribute:     protocolMapper.Config[addressRegionAttributeField],
+		PostalCodeAttribute: protocolMapper.Config[addressPostalCodeAttributeField],
+		CountryAttribute:    protocolMapper.Config[addressCountryAttributeField],
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) GetOpenIdAddressProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) (*OpenIdAddressProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToOpenIdAddressProtocolMapper(realmId, clientId, clientScopeId)
+}
+
+func (keycloakClient *KeycloakClient) DeleteOpenIdAddressProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
+}
+
+func (keycloakClient *KeycloakClient) NewOpenIdAddressProtocolMapper(ctx context.Context, mapper *OpenIdAddressProtocolMapper) error {
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper())
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateOpenIdAddressProtocolMapper(ctx context.Context, mapper *OpenIdAddressProtocolMapper) error {
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper())
+}
+
+func (keycloakClient *KeycloakClient) ValidateOpenIdAddressProtocolMapper(ctx context.Context, mapper *OpenIdAddressProtocolMapper) error {
+	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
+		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	return nil
+}
@@ -61,6 +61,17 @@ type OpenidClient struct {
 	AlwaysDisplayInConsole             bool                                     `json:"alwaysDisplayInConsole"`
 }
 
+// the attributes set by Keycloak when a client policy with the secret-rotation executor applies to the client. They are
+// read from the extra config of the client, and are never part of the extra_config of the resource, so the provider
+// doesn't send them on updates and Keycloak keeps managing them.
+const (
+	OpenidClientSecretExpirationTimeAttribute        = "client.secret.expiration.time"
+	OpenidClientSecretRotatedExpirationTimeAttribute = "client.secret.rotated.expiration.time"
+)
+
+// FederatedJwtClientAuthenticator authenticates clients with the tokens issued to them by an identity provider
+const FederatedJwtClientAuthenticator = "federated-jwt"
+
 type OpenidClientAttributes struct {
 	PkceCodeChallengeMethod                  string                           `json:"pkce.code.challenge.method"`
 	ExcludeSessionStateFromAuthResponse      types.KeycloakBoolQuoted         `json:"exclude.session.state.from.auth.response"`
@@ -86,6 +97,11 @@ type OpenidClientAttributes struct {
 	PostLogoutRedirectUris                   types.KeycloakSliceHashDelimited `json:"post.logout.redirect.uris,omitempty"`
 	StandardTokenExchangeEnabled             types.KeycloakBoolQuoted         `json:"standard.token.exchange.enabled,omitempty"`
 	AllowRefreshTokenInStandardTokenExchange string                           `json:"standard.token.exchange.enableRefreshRequestedTokenType,omitempty"`
+	JwtCredentialIssuer                      string                           `json:"jwt.credential.issuer,omitempty"`
+	JwtCredentialSubject                     string                           `json:"jwt.credential.sub,omitempty"`
+	UseJwksUrl                               types.KeycloakBoolQuoted         `json:"use.jwks.url"`
+	JwksUrl                                  string                           `json:"jwks.url"`
+	TlsClientAuthSubjectDn                   string                           `json:"x509.subjectdn"`
 }
 
 type OpenidAuthenticationFlowBindingOverrides struct {
@@ -132,6 +148,14 @@ func (keycloakClient *KeycloakClient) ValidateOpenidClient(ctx context.Context,
 		return fmt.Errorf("validation error: standard token exchange cannot be enabled on public clients")
 	}
 
+	if client.ClientAuthenticatorType == FederatedJwtClientAuthenticator && (client.Attributes.JwtCredentialIssuer == "" || client.Attributes.JwtCredentialSubject == "") {
+		return fmt.Errorf("validation error: the %s client authenticator requires an identity provider and a subject", FederatedJwtClientAuthenticator)
+	}
+
+	if client.Attributes.UseJwksUrl == true && client.Attributes.JwksUrl == "" {
+		return fmt.Errorf("validation error: use_jwks_url requires a jwks_url")
+	}
+
 	return nil
 }
 
@@ -332,6 +356,33 @@ func (keycloakClient *KeycloakClient) RegenerateOpenIdClientSecret(ctx context.C
 	return &clientSecret, nil
 }
 
+// GetOpenidClientRotatedSecret returns the previous secret of a client, kept until it expires when a client policy with
+// the secret-rotation executor applies to the client. It returns nil when the client has no rotated secret.
+func (keycloakClient *KeycloakClient) GetOpenidClientRotatedSecret(ctx context.Context, realmId, id string) (*OpenidClientSecret, error) {
+	var clientSecret OpenidClientSecret
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/client-secret/rotated", realmId, id), &clientSecret, nil)
+	if err != nil {
+		if ErrorIs404(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &clientSecret, nil
+}
+
+// InvalidateOpenidClientRotatedSecret removes the rotated secret of a client before it expires
+func (keycloakClient *KeycloakClient) InvalidateOpenidClientRotatedSecret(ctx context.Context, realmId, id string) error {
+	err := keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients/%s/client-secret/rotated", realmId, id), nil)
+	if err != nil && !ErrorIs404(err) {
+		return err
+	}
+
+	return nil
+}
+
 func (keycloakClient *KeycloakClient) AttachOpenidClientDefaultScopes(ctx context.Context, realmId, clientId string, scopeNames []string) error {
 	return keycloakClient.attachOpenidClientScopes(ctx, realmId, clientId, "default", scopeNames)
 }
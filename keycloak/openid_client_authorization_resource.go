@@ -51,6 +51,9 @@ func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationResourceByName
 	if err != nil {
 		return nil, err
 	}
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("unable to find client authorization resource with name %s", name)
+	}
 	resource := resources[0]
 	resource.RealmId = realmId
 	resource.ResourceServerId = resourceServerId
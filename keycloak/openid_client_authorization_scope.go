@@ -39,6 +39,23 @@ func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationScope(ctx cont
 	return &scope, nil
 }
 
+func (keycloakClient *KeycloakClient) GetOpenidClientAuthorizationScopeByName(ctx context.Context, realmId, resourceServerId, name string) (*OpenidClientAuthorizationScope, error) {
+	var scopes []OpenidClientAuthorizationScope
+	params := map[string]string{"name": name}
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope", realmId, resourceServerId), &scopes, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range scopes {
+		if scope.Name == name {
+			scope.RealmId = realmId
+			scope.ResourceServerId = resourceServerId
+			return &scope, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to find client authorization scope with name %s", name)
+}
+
 func (keycloakClient *KeycloakClient) UpdateOpenidClientAuthorizationScope(ctx context.Context, scope *OpenidClientAuthorizationScope) error {
 	err := keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s/authz/resource-server/scope/%s", scope.RealmId, scope.ResourceServerId, scope.Id), scope)
 	if err != nil {
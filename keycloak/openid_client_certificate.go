@@ -0,0 +1,112 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+)
+
+// the formats of the keys uploaded to a client, as named by the admin API
+const (
+	OpenidClientCertificatePemFormat = "Certificate PEM"
+	OpenidClientPublicKeyPemFormat   = "Public Key PEM"
+	OpenidClientJwksFormat           = "JSON Web Key Set"
+)
+
+// the client-jwt authenticator checks the signed JWTs of a client with the keys stored under this prefix
+const openidClientCertificateAttributePrefix = "jwt.credential"
+
+// OpenidClientCertificate holds the keys the client-jwt authenticator checks the signed JWTs of a client with. The
+// private key is only set on the certificates generated by Keycloak, and only when they are generated.
+type OpenidClientCertificate struct {
+	PrivateKey  string `json:"privateKey,omitempty"`
+	PublicKey   string `json:"publicKey,omitempty"`
+	Certificate string `json:"certificate,omitempty"`
+	Kid         string `json:"kid,omitempty"`
+}
+
+func (keycloakClient *KeycloakClient) GetOpenidClientCertificate(ctx context.Context, realmId, clientId string) (*OpenidClientCertificate, error) {
+	var certificate OpenidClientCertificate
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s/certificates/%s", realmId, clientId, openidClientCertificateAttributePrefix), &certificate, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+// UploadOpenidClientCertificate replaces the keys of a client with a certificate, a public key or a JSON Web Key Set,
+// depending on the format
+func (keycloakClient *KeycloakClient) UploadOpenidClientCertificate(ctx context.Context, realmId, clientId, format string, content []byte) (*OpenidClientCertificate, error) {
+	body, err := keycloakClient.postMultipart(ctx, fmt.Sprintf("/realms/%s/clients/%s/certificates/%s/upload-certificate", realmId, clientId, openidClientCertificateAttributePrefix), map[string]string{
+		"keystoreFormat": format,
+	}, map[string][]byte{
+		"file": content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var certificate OpenidClientCertificate
+	if err := json.Unmarshal(body, &certificate); err != nil {
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+// GenerateOpenidClientCertificate has Keycloak generate a key pair and a self-signed certificate for a client. Keycloak
+// stores the private key along with the certificate, so the certificate is uploaded again on its own to remove it.
+func (keycloakClient *KeycloakClient) GenerateOpenidClientCertificate(ctx context.Context, realmId, clientId string) (*OpenidClientCertificate, error) {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients/%s/certificates/%s/generate", realmId, clientId, openidClientCertificateAttributePrefix), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var generated OpenidClientCertificate
+	if err := json.Unmarshal(body, &generated); err != nil {
+		return nil, err
+	}
+
+	der, err := base64.StdEncoding.DecodeString(generated.Certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := keycloakClient.UploadOpenidClientCertificate(ctx, realmId, clientId, OpenidClientCertificatePemFormat, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: der,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	certificate.PrivateKey = generated.PrivateKey
+
+	return certificate, nil
+}
+
+// DeleteOpenidClientCertificate removes the keys of a client. The admin API has no endpoint for it, so the attributes
+// holding them are emptied, which makes Keycloak remove them.
+func (keycloakClient *KeycloakClient) DeleteOpenidClientCertificate(ctx context.Context, realmId, clientId string) error {
+	var client map[string]interface{}
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients/%s", realmId, clientId), &client, nil)
+	if err != nil {
+		return err
+	}
+
+	attributes, ok := client["attributes"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, attribute := range []string{"certificate", "public.key", "private.key", "kid"} {
+		attributes[fmt.Sprintf("%s.%s", openidClientCertificateAttributePrefix, attribute)] = ""
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/clients/%s", realmId, clientId), client)
+}
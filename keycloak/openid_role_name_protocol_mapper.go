@@ -0,0 +1,111 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+type OpenIdRoleNameProtocolMapper struct {
+	Id            string
+	Name          string
+	RealmId       string
+	ClientId      string
+	ClientScopeId string
+
+	RoleId      string
+	NewRoleName string
+}
+
+func (mapper *OpenIdRoleNameProtocolMapper) convertToGenericProtocolMapper(roleProp string) *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "openid-connect",
+		ProtocolMapper: "oidc-role-name-mapper",
+		Config: map[string]string{
+			roleField:        roleProp,
+			newRoleNameField: mapper.NewRoleName,
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToOpenIdRoleNameProtocolMapper(realmId, clientId, clientScopeId, roleId string) *OpenIdRoleNameProtocolMapper {
+	return &OpenIdRoleNameProtocolMapper{
+		Id:            protocolMapper.Id,
+		Name:          protocolMapper.Name,
+		RealmId:       realmId,
+		ClientId:      clientId,
+		ClientScopeId: clientScopeId,
+
+		RoleId:      roleId,
+		NewRoleName: protocolMapper.Config[newRoleNameField],
+	}
+}
+
+func (keycloakClient *KeycloakClient) GetOpenIdRoleNameProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) (*OpenIdRoleNameProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	roleId, err := keycloakClient.getRoleIdFromRoleProp(ctx, realmId, protocolMapper.Config[roleField])
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToOpenIdRoleNameProtocolMapper(realmId, clientId, clientScopeId, roleId), nil
+}
+
+func (keycloakClient *KeycloakClient) DeleteOpenIdRoleNameProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
+}
+
+func (keycloakClient *KeycloakClient) NewOpenIdRoleNameProtocolMapper(ctx context.Context, mapper *OpenIdRoleNameProtocolMapper) error {
+	roleProp, err := keycloakClient.getRolePropFromRoleId(ctx, mapper.RealmId, mapper.RoleId)
+	if err != nil {
+		return err
+	}
+
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper(roleProp))
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateOpenIdRoleNameProtocolMapper(ctx context.Context, mapper *OpenIdRoleNameProtocolMapper) error {
+	roleProp, err := keycloakClient.getRolePropFromRoleId(ctx, mapper.RealmId, mapper.RoleId)
+	if err != nil {
+		return err
+	}
+
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper(roleProp))
+}
+
+func (keycloakClient *KeycloakClient) ValidateOpenIdRoleNameProtocolMapper(ctx context.Context, mapper *OpenIdRoleNameProtocolMapper) error {
+	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
+		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	return nil
+}
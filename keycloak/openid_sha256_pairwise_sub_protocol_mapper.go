@@ -0,0 +1,147 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type OpenIdSha256PairwiseSubProtocolMapper struct {
+	Id            string
+	Name          string
+	RealmId       string
+	ClientId      string
+	ClientScopeId string
+
+	SectorIdentifierUri      string
+	PairwiseSubAlgorithmSalt string
+}
+
+func (mapper *OpenIdSha256PairwiseSubProtocolMapper) convertToGenericProtocolMapper() *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "openid-connect",
+		ProtocolMapper: "oidc-sha256-pairwise-sub-mapper",
+		Config: map[string]string{
+			sectorIdentifierUriField:      mapper.SectorIdentifierUri,
+			pairwiseSubAlgorithmSaltField: mapper.PairwiseSubAlgorithmSalt,
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToOpenIdSha256PairwiseSubProtocolMapper(realmId, clientId, clientScopeId string) *OpenIdSha256PairwiseSubProtocolMapper {
+	return &OpenIdSha256PairwiseSubProtocolMapper{
+		Id:            protocolMapper.Id,
+		Name:          protocolMapper.Name,
+		RealmId:       realmId,
+		ClientId:      clientId,
+		ClientScopeId: clientScopeId,
+
+		SectorIdentifierUri:      protocolMapper.Config[sectorIdentifierUriField],
+		PairwiseSubAlgorithmSalt: protocolMapper.Config[pairwiseSubAlgorithmSaltField],
+	}
+}
+
+func (keycloakClient *KeycloakClient) GetOpenIdSha256PairwiseSubProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) (*OpenIdSha256PairwiseSubProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToOpenIdSha256PairwiseSubProtocolMapper(realmId, clientId, clientScopeId), nil
+}
+
+func (keycloakClient *KeycloakClient) DeleteOpenIdSha256PairwiseSubProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
+}
+
+func (keycloakClient *KeycloakClient) NewOpenIdSha256PairwiseSubProtocolMapper(ctx context.Context, mapper *OpenIdSha256PairwiseSubProtocolMapper) error {
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper())
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateOpenIdSha256PairwiseSubProtocolMapper(ctx context.Context, mapper *OpenIdSha256PairwiseSubProtocolMapper) error {
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper())
+}
+
+func (keycloakClient *KeycloakClient) ValidateOpenIdSha256PairwiseSubProtocolMapper(ctx context.Context, mapper *OpenIdSha256PairwiseSubProtocolMapper) error {
+	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
+		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	if mapper.SectorIdentifierUri != "" {
+		sectorIdentifierUri, err := url.Parse(mapper.SectorIdentifierUri)
+		if err != nil || !sectorIdentifierUri.IsAbs() || sectorIdentifierUri.Host == "" {
+			return fmt.Errorf("validation error: SectorIdentifierUri %s must be an absolute URL", mapper.SectorIdentifierUri)
+		}
+
+		// the redirect URIs are then checked by Keycloak against the ones listed by the sector identifier URI
+		return nil
+	}
+
+	if mapper.ClientId == "" {
+		return nil
+	}
+
+	client, err := keycloakClient.GetOpenidClient(ctx, mapper.RealmId, mapper.ClientId)
+	if err != nil {
+		return err
+	}
+
+	hosts, err := getRedirectUriHosts(client)
+	if err != nil {
+		return err
+	}
+
+	if len(hosts) > 1 {
+		return fmt.Errorf("validation error: the redirect URIs of client %s have several hosts, SectorIdentifierUri must be set", client.ClientId)
+	}
+
+	return nil
+}
+
+// getRedirectUriHosts lists the hosts of the redirect URIs of a client, the relative redirect URIs being resolved
+// against the root URL of the client like Keycloak does
+func getRedirectUriHosts(client *OpenidClient) (map[string]bool, error) {
+	hosts := map[string]bool{}
+
+	for _, redirectUri := range client.ValidRedirectUris {
+		if strings.HasPrefix(redirectUri, "/") && client.RootUrl != nil {
+			redirectUri = *client.RootUrl + redirectUri
+		}
+
+		parsedRedirectUri, err := url.Parse(redirectUri)
+		if err != nil {
+			return nil, fmt.Errorf("validation error: redirect URI %s of client %s is invalid: %w", redirectUri, client.ClientId, err)
+		}
+
+		hosts[parsedRedirectUri.Host] = true
+	}
+
+	return hosts, nil
+}
@@ -0,0 +1,35 @@
+package keycloak
+
+import (
+	"testing"
+)
+
+func TestGetRedirectUriHosts(t *testing.T) {
+	rootUrl := "https://app.example.com"
+
+	client := &OpenidClient{
+		ClientId:          "client",
+		RootUrl:           &rootUrl,
+		ValidRedirectUris: []string{"/callback", "https://app.example.com/other/*"},
+	}
+
+	hosts, err := getRedirectUriHosts(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(hosts) != 1 || !hosts["app.example.com"] {
+		t.Fatalf("expected the relative redirect URI to resolve to the host of the root URL, got %v", hosts)
+	}
+
+	client.ValidRedirectUris = append(client.ValidRedirectUris, "https://other.example.com/callback")
+
+	hosts, err = getRedirectUriHosts(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(hosts) != 2 {
+		t.Fatalf("expected two hosts, got %v", hosts)
+	}
+}
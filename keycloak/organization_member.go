@@ -0,0 +1,101 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const (
+	OrganizationMembershipTypeManaged   = "MANAGED"
+	OrganizationMembershipTypeUnmanaged = "UNMANAGED"
+)
+
+// OrganizationMember is a user that belongs to an organization. Managed members are created by the identity providers
+// of the organization, and their account is deleted along with their membership.
+type OrganizationMember struct {
+	User
+	MembershipType string `json:"membershipType"`
+}
+
+type OrganizationMembersQuery struct {
+	Search         string
+	Exact          bool
+	MembershipType string
+	// First and Max select a page of members, all the members are returned when Max is 0
+	First int
+	Max   int
+}
+
+type OrganizationInvitation struct {
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+func (keycloakClient *KeycloakClient) GetOrganizationMembers(ctx context.Context, realm, organizationId string, query *OrganizationMembersQuery) ([]*OrganizationMember, error) {
+	var members []*OrganizationMember
+
+	params := map[string]string{}
+	if query.Search != "" {
+		params["search"] = query.Search
+		params["exact"] = strconv.FormatBool(query.Exact)
+	}
+	if query.MembershipType != "" {
+		params["membershipType"] = query.MembershipType
+	}
+
+	first, pagination := query.First, 50
+	if query.Max != 0 {
+		pagination = query.Max
+	}
+
+	for {
+		var pageMembers []*OrganizationMember
+
+		params["first"] = strconv.Itoa(first)
+		params["max"] = strconv.Itoa(pagination)
+
+		err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members", realm, organizationId), &pageMembers, params)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, pageMembers...)
+
+		if query.Max != 0 || len(pageMembers) < pagination {
+			break
+		}
+		first += pagination
+	}
+
+	for _, member := range members {
+		member.RealmId = realm
+	}
+
+	return members, nil
+}
+
+func (keycloakClient *KeycloakClient) AddOrganizationMember(ctx context.Context, realm, organizationId, userId string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members", realm, organizationId), userId)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) RemoveOrganizationMember(ctx context.Context, realm, organizationId, userId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members/%s", realm, organizationId, userId), nil)
+}
+
+// InviteOrganizationMember sends an email inviting someone without an account to register and join an organization
+func (keycloakClient *KeycloakClient) InviteOrganizationMember(ctx context.Context, realm, organizationId string, invitation *OrganizationInvitation) error {
+	form := url.Values{}
+	form.Set("email", invitation.Email)
+	if invitation.FirstName != "" {
+		form.Set("firstName", invitation.FirstName)
+	}
+	if invitation.LastName != "" {
+		form.Set("lastName", invitation.LastName)
+	}
+
+	return keycloakClient.postForm(ctx, fmt.Sprintf("/realms/%s/organizations/%s/members/invite-user", realm, organizationId), form)
+}
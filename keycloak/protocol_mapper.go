@@ -19,8 +19,17 @@ var (
 	addToIdTokenField                    = "id.token.claim"
 	addToUserInfoField                   = "userinfo.token.claim"
 	addToTokenIntrospectionField         = "introspection.token.claim"
+	addOrganizationAttributesField       = "addOrganizationAttributes"
+	addOrganizationIdField               = "addOrganizationId"
+	addressCountryAttributeField         = "user.attribute.country"
+	addressFormattedAttributeField       = "user.attribute.formatted"
+	addressLocalityAttributeField        = "user.attribute.locality"
+	addressPostalCodeAttributeField      = "user.attribute.postal_code"
+	addressRegionAttributeField          = "user.attribute.region"
+	addressStreetAttributeField          = "user.attribute.street"
 	attributeNameField                   = "attribute.name"
 	attributeNameFormatField             = "attribute.nameformat"
+	attributeValueField                  = "attribute.value"
 	claimNameField                       = "claim.name"
 	claimValueField                      = "claim.value"
 	claimValueTypeField                  = "jsonType.label"
@@ -29,6 +38,9 @@ var (
 	includedClientAudienceField          = "included.client.audience"
 	includedCustomAudienceField          = "included.custom.audience"
 	multivaluedField                     = "multivalued"
+	newRoleNameField                     = "new.role.name"
+	pairwiseSubAlgorithmSaltField        = "pairwiseSubAlgorithmSalt"
+	sectorIdentifierUriField             = "sectorIdentifierUri"
 	samlScriptField                      = "Script" // needs to start with uppercase S for SAML script mapper
 	scriptField                          = "script"
 	singleValueAttributeField            = "single"
@@ -67,3 +79,19 @@ func (keycloakClient *KeycloakClient) listGenericProtocolMappers(ctx context.Con
 
 	return protocolMappers, nil
 }
+
+// GetProtocolMapperIdByName finds the id of a protocol mapper attached to a client or a client scope from its name
+func (keycloakClient *KeycloakClient) GetProtocolMapperIdByName(ctx context.Context, realmId, clientId, clientScopeId, name string) (string, error) {
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, realmId, clientId, clientScopeId)
+	if err != nil {
+		return "", err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == name {
+			return protocolMapper.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("no protocol mapper with name %s found", name)
+}
@@ -31,6 +31,9 @@ type Realm struct {
 	UserManagedAccess    bool   `json:"userManagedAccessAllowed"`
 	OrganizationsEnabled bool   `json:"organizationsEnabled,omitempty"`
 
+	// fine-grained admin permissions v2, only sent to Keycloak 26.2 and later
+	AdminPermissionsEnabled *bool `json:"adminPermissionsEnabled,omitempty"`
+
 	// Login Config
 	RegistrationAllowed         bool   `json:"registrationAllowed"`
 	RegistrationEmailAsUsername bool   `json:"registrationEmailAsUsername"`
@@ -25,6 +25,22 @@ func (keycloakClient *KeycloakClient) GetRealmOptionalClientScopes(ctx context.C
 	return keycloakClient.getRealmClientScopesOfType(ctx, realmId, "optional")
 }
 
+// ListClientScopes lists the client scopes of a realm, regardless of their protocol
+func (keycloakClient *KeycloakClient) ListClientScopes(ctx context.Context, realmId string) ([]*OpenidClientScope, error) {
+	var clientScopes []*OpenidClientScope
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-scopes", realmId), &clientScopes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, clientScope := range clientScopes {
+		clientScope.RealmId = realmId
+	}
+
+	return clientScopes, nil
+}
+
 func (keycloakClient *KeycloakClient) resolveClientScopeNamesIntoIds(ctx context.Context, realmId string, scopeNames []string) ([]string, error) {
 	var scopeIds []string
 	var clientScopes []OpenidClientScope
@@ -49,6 +65,16 @@ ScopeNames:
 	return scopeIds, nil
 }
 
+// GetClientScopeIdByName finds the id of a client scope from its name, regardless of its protocol
+func (keycloakClient *KeycloakClient) GetClientScopeIdByName(ctx context.Context, realmId, name string) (string, error) {
+	scopeIds, err := keycloakClient.resolveClientScopeNamesIntoIds(ctx, realmId, []string{name})
+	if err != nil {
+		return "", err
+	}
+
+	return scopeIds[0], nil
+}
+
 func (keycloakClient *KeycloakClient) resolveAndHandleClientScopes(ctx context.Context, realmId string, scopeNames []string, handler func(context.Context, string, string) error) error {
 	scopeIds, err := keycloakClient.resolveClientScopeNamesIntoIds(ctx, realmId, scopeNames)
 	if err != nil {
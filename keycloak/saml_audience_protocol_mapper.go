@@ -0,0 +1,111 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+type SamlAudienceProtocolMapper struct {
+	Id            string
+	Name          string
+	RealmId       string
+	ClientId      string
+	ClientScopeId string
+
+	IncludedClientAudience string
+	IncludedCustomAudience string
+}
+
+func (mapper *SamlAudienceProtocolMapper) convertToGenericProtocolMapper() *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "saml",
+		ProtocolMapper: "saml-audience-mapper",
+		Config: map[string]string{
+			includedClientAudienceField: mapper.IncludedClientAudience,
+			includedCustomAudienceField: mapper.IncludedCustomAudience,
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToSamlAudienceProtocolMapper(realmId, clientId, clientScopeId string) *SamlAudienceProtocolMapper {
+	return &SamlAudienceProtocolMapper{
+		Id:            protocolMapper.Id,
+		Name:          protocolMapper.Name,
+		RealmId:       realmId,
+		ClientId:      clientId,
+		ClientScopeId: clientScopeId,
+
+		IncludedClientAudience: protocolMapper.Config[includedClientAudienceField],
+		IncludedCustomAudience: protocolMapper.Config[includedCustomAudienceField],
+	}
+}
+
+func (keycloakClient *KeycloakClient) GetSamlAudienceProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) (*SamlAudienceProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToSamlAudienceProtocolMapper(realmId, clientId, clientScopeId), nil
+}
+
+func (keycloakClient *KeycloakClient) DeleteSamlAudienceProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
+}
+
+func (keycloakClient *KeycloakClient) NewSamlAudienceProtocolMapper(ctx context.Context, mapper *SamlAudienceProtocolMapper) error {
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper())
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateSamlAudienceProtocolMapper(ctx context.Context, mapper *SamlAudienceProtocolMapper) error {
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper())
+}
+
+func (keycloakClient *KeycloakClient) ValidateSamlAudienceProtocolMapper(ctx context.Context, mapper *SamlAudienceProtocolMapper) error {
+	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
+		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+	}
+
+	if mapper.IncludedClientAudience == "" && mapper.IncludedCustomAudience == "" {
+		return fmt.Errorf("validation error: one of IncludedClientAudience or IncludedCustomAudience must be set")
+	}
+
+	if mapper.IncludedClientAudience != "" && mapper.IncludedCustomAudience != "" {
+		return fmt.Errorf("validation error: IncludedClientAudience and IncludedCustomAudience cannot both be set")
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	if mapper.IncludedClientAudience != "" {
+		_, err = keycloakClient.GetGenericClientByClientId(ctx, mapper.RealmId, mapper.IncludedClientAudience)
+		if err != nil {
+			return fmt.Errorf("validation error: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type SamlGroupMembershipProtocolMapper struct {
+	Id            string
+	Name          string
+	RealmId       string
+	ClientId      string
+	ClientScopeId string
+
+	GroupAttributeName      string
+	FriendlyName            string
+	SamlAttributeNameFormat string
+	SingleGroupAttribute    bool
+	FullPath                bool
+}
+
+func (mapper *SamlGroupMembershipProtocolMapper) convertToGenericProtocolMapper() *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "saml",
+		ProtocolMapper: "saml-group-membership-mapper",
+		Config: map[string]string{
+			attributeNameField:        mapper.GroupAttributeName,
+			attributeNameFormatField:  mapper.SamlAttributeNameFormat,
+			friendlyNameField:         mapper.FriendlyName,
+			singleValueAttributeField: strconv.FormatBool(mapper.SingleGroupAttribute),
+			fullPathField:             strconv.FormatBool(mapper.FullPath),
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToSamlGroupMembershipProtocolMapper(realmId, clientId, clientScopeId string) (*SamlGroupMembershipProtocolMapper, error) {
+	singleGroupAttribute, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[singleValueAttributeField])
+	if err != nil {
+		return nil, err
+	}
+
+	fullPath, err := parseBoolAndTreatEmptyStringAsFalse(protocolMapper.Config[fullPathField])
+	if err != nil {
+		return nil, err
+	}
+
+	return &SamlGroupMembershipProtocolMapper{
+		Id:            protocolMapper.Id,
+		Name:          protocolMapper.Name,
+		RealmId:       realmId,
+		ClientId:      clientId,
+		ClientScopeId: clientScopeId,
+
+		GroupAttributeName:      protocolMapper.Config[attributeNameField],
+		FriendlyName:            protocolMapper.Config[friendlyNameField],
+		SamlAttributeNameFormat: protocolMapper.Config[attributeNameFormatField],
+		SingleGroupAttribute:    singleGroupAttribute,
+		FullPath:                fullPath,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) GetSamlGroupMembershipProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) (*SamlGroupMembershipProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToSamlGroupMembershipProtocolMapper(realmId, clientId, clientScopeId)
+}
+
+func (keycloakClient *KeycloakClient) DeleteSamlGroupMembershipProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
+}
+
+func (keycloakClient *KeycloakClient) NewSamlGroupMembershipProtocolMapper(ctx context.Context, mapper *SamlGroupMembershipProtocolMapper) error {
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper())
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateSamlGroupMembershipProtocolMapper(ctx context.Context, mapper *SamlGroupMembershipProtocolMapper) error {
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper())
+}
+
+func (keycloakClient *KeycloakClient) ValidateSamlGroupMembershipProtocolMapper(ctx context.Context, mapper *SamlGroupMembershipProtocolMapper) error {
+	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
+		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	return nil
+}
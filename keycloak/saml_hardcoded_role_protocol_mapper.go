@@ -0,0 +1,150 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+type SamlHardcodedRoleProtocolMapper struct {
+	Id            string
+	Name          string
+	RealmId       string
+	ClientId      string
+	ClientScopeId string
+
+	RoleId string
+}
+
+// getRolePropFromRoleId builds the role reference of the role mappers, which is the name of the role prefixed by the
+// client id of its client for the client roles. Unlike getRolePropFromRole, the client of the role may be a SAML client.
+func (keycloakClient *KeycloakClient) getRolePropFromRoleId(ctx context.Context, realmId, roleId string) (string, error) {
+	role, err := keycloakClient.GetRole(ctx, realmId, roleId)
+	if err != nil {
+		return "", err
+	}
+
+	if role.ClientRole {
+		client, err := keycloakClient.GetGenericClient(ctx, realmId, role.ContainerId)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s.%s", client.ClientId, role.Name), nil
+	}
+
+	return role.Name, nil
+}
+
+// getRoleIdFromRoleProp finds the id of the role referenced by a role mapper
+func (keycloakClient *KeycloakClient) getRoleIdFromRoleProp(ctx context.Context, realmId, roleProp string) (string, error) {
+	roleClientId, roleName := parseRoleClientIdAndName(roleProp)
+
+	var roleClientUId = ""
+	if roleClientId != "" {
+		client, err := keycloakClient.GetGenericClientByClientId(ctx, realmId, roleClientId)
+		if err != nil {
+			return "", err
+		}
+
+		roleClientUId = client.Id
+	}
+
+	role, err := keycloakClient.GetRoleByName(ctx, realmId, roleClientUId, roleName)
+	if err != nil {
+		return "", err
+	}
+
+	return role.Id, nil
+}
+
+func (mapper *SamlHardcodedRoleProtocolMapper) convertToGenericProtocolMapper(roleProp string) *protocolMapper {
+	return &protocolMapper{
+		Id:             mapper.Id,
+		Name:           mapper.Name,
+		Protocol:       "saml",
+		ProtocolMapper: "saml-hardcode-role-mapper",
+		Config: map[string]string{
+			roleField: roleProp,
+		},
+	}
+}
+
+func (protocolMapper *protocolMapper) convertToSamlHardcodedRoleProtocolMapper(realmId, clientId, clientScopeId, roleId string) *SamlHardcodedRoleProtocolMapper {
+	return &SamlHardcodedRoleProtocolMapper{
+		Id:            protocolMapper.Id,
+		Name:          protocolMapper.Name,
+		RealmId:       realmId,
+		ClientId:      clientId,
+		ClientScopeId: clientScopeId,
+
+		RoleId: roleId,
+	}
+}
+
+func (keycloakClient *KeycloakClient) GetSamlHardcodedRoleProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) (*SamlHardcodedRoleProtocolMapper, error) {
+	var protocolMapper *protocolMapper
+
+	err := keycloakClient.get(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), &protocolMapper, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	roleId, err := keycloakClient.getRoleIdFromRoleProp(ctx, realmId, protocolMapper.Config[roleField])
+	if err != nil {
+		return nil, err
+	}
+
+	return protocolMapper.convertToSamlHardcodedRoleProtocolMapper(realmId, clientId, clientScopeId, roleId), nil
+}
+
+func (keycloakClient *KeycloakClient) DeleteSamlHardcodedRoleProtocolMapper(ctx context.Context, realmId, clientId, clientScopeId, mapperId string) error {
+	return keycloakClient.delete(ctx, individualProtocolMapperPath(realmId, clientId, clientScopeId, mapperId), nil)
+}
+
+func (keycloakClient *KeycloakClient) NewSamlHardcodedRoleProtocolMapper(ctx context.Context, mapper *SamlHardcodedRoleProtocolMapper) error {
+	roleProp, err := keycloakClient.getRolePropFromRoleId(ctx, mapper.RealmId, mapper.RoleId)
+	if err != nil {
+		return err
+	}
+
+	path := protocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+
+	_, location, err := keycloakClient.post(ctx, path, mapper.convertToGenericProtocolMapper(roleProp))
+	if err != nil {
+		return err
+	}
+
+	mapper.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateSamlHardcodedRoleProtocolMapper(ctx context.Context, mapper *SamlHardcodedRoleProtocolMapper) error {
+	roleProp, err := keycloakClient.getRolePropFromRoleId(ctx, mapper.RealmId, mapper.RoleId)
+	if err != nil {
+		return err
+	}
+
+	path := individualProtocolMapperPath(mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+
+	return keycloakClient.put(ctx, path, mapper.convertToGenericProtocolMapper(roleProp))
+}
+
+func (keycloakClient *KeycloakClient) ValidateSamlHardcodedRoleProtocolMapper(ctx context.Context, mapper *SamlHardcodedRoleProtocolMapper) error {
+	if mapper.ClientId == "" && mapper.ClientScopeId == "" {
+		return fmt.Errorf("validation error: one of ClientId or ClientScopeId must be set")
+	}
+
+	protocolMappers, err := keycloakClient.listGenericProtocolMappers(ctx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId)
+	if err != nil {
+		return err
+	}
+
+	for _, protocolMapper := range protocolMappers {
+		if protocolMapper.Name == mapper.Name && protocolMapper.Id != mapper.Id {
+			return fmt.Errorf("validation error: a protocol mapper with name %s already exists for this client", mapper.Name)
+		}
+	}
+
+	return nil
+}
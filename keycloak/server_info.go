@@ -1,13 +1,31 @@
 package keycloak
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
 
 type SystemInfo struct {
 	ServerVersion string `json:"version"`
 }
 
 type ComponentType struct {
-	Id string `json:"id"`
+	Id         string               `json:"id"`
+	HelpText   string               `json:"helpText"`
+	Properties []ConfigPropertyType `json:"properties"`
+}
+
+// ConfigPropertyType describes a config property of a component type
+type ConfigPropertyType struct {
+	Name         string      `json:"name"`
+	Label        string      `json:"label"`
+	Type         string      `json:"type"`
+	DefaultValue interface{} `json:"defaultValue"`
+	Options      []string    `json:"options"`
+	Secret       bool        `json:"secret"`
+	Required     bool        `json:"required"`
 }
 
 type ProviderType struct {
@@ -54,6 +72,32 @@ func (serverInfo *ServerInfo) ComponentTypeIsInstalled(componentType, componentT
 	return false
 }
 
+// GetComponentType returns the component type of a provider, such as the rsa-generated provider of the
+// org.keycloak.keys.KeyProvider type
+func (serverInfo *ServerInfo) GetComponentType(providerType, providerId string) (*ComponentType, error) {
+	componentTypes, ok := serverInfo.ComponentTypes[providerType]
+	if !ok {
+		providerTypes := make([]string, 0, len(serverInfo.ComponentTypes))
+		for t := range serverInfo.ComponentTypes {
+			providerTypes = append(providerTypes, t)
+		}
+		sort.Strings(providerTypes)
+
+		return nil, fmt.Errorf("provider type %s is not supported by the server, supported provider types are: %s", providerType, strings.Join(providerTypes, ", "))
+	}
+
+	providerIds := make([]string, 0, len(componentTypes))
+	for _, componentType := range componentTypes {
+		if componentType.Id == providerId {
+			return &componentType, nil
+		}
+		providerIds = append(providerIds, componentType.Id)
+	}
+	sort.Strings(providerIds)
+
+	return nil, fmt.Errorf("provider %s of type %s is not installed, installed providers are: %s", providerId, providerType, strings.Join(providerIds, ", "))
+}
+
 func (serverInfo *ServerInfo) getInstalledProvidersNames(providerType string) []string {
 	providers := serverInfo.ProviderTypes[providerType].Providers
 	keys := make([]string, 0, len(providers))
@@ -26,6 +26,9 @@ type User struct {
 	Attributes          map[string][]string `json:"attributes"`
 	FederatedIdentities FederatedIdentities `json:"federatedIdentities"`
 	RequiredActions     []string            `json:"requiredActions"`
+
+	// id of the client owning this user when it is a service account
+	ServiceAccountClientId string `json:"serviceAccountClientId,omitempty"`
 }
 
 type PasswordCredentials struct {
@@ -32,6 +32,7 @@ const (
 	Version_26_1 Version = "26.1.0"
 	Version_26_2 Version = "26.2.0"
 	Version_26_3 Version = "26.3.0"
+	Version_26_4 Version = "26.4.0"
 )
 
 func (v Version) AsVersion() *version.Version {
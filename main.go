@@ -1,14 +1,27 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/keycloak/terraform-provider-keycloak/generate"
 	"github.com/keycloak/terraform-provider-keycloak/provider"
 )
 
 func main() {
 
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate.Run(context.Background(), os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var debugMode bool
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakAdminPermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakAdminPermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the admin-permissions client, on which the policies of the admin permissions are managed",
+			},
+			"resource_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scopes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakAdminPermissionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	resourceServerId, err := getAdminPermissionsResourceServerId(ctx, keycloakClient, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var resourceTypes []interface{}
+	for _, resourceType := range keycloak.AdminPermissionResourceTypes {
+		scopes, err := keycloakClient.GetAdminPermissionResourceTypeScopes(ctx, realmId, resourceServerId, resourceType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		resourceTypes = append(resourceTypes, map[string]interface{}{
+			"name":   resourceType,
+			"scopes": scopes,
+		})
+	}
+
+	data.SetId(realmId)
+	data.Set("resource_server_id", resourceServerId)
+	data.Set("resource_types", resourceTypes)
+
+	return nil
+}
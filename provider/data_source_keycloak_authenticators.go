@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakAuthenticators() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakAuthenticatorsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authenticators":        authenticatorProvidersSchema("Authenticators which can run in an execution of a flow"),
+			"form_actions":          authenticatorProvidersSchema("Form actions which can run in an execution of a form flow"),
+			"client_authenticators": authenticatorProvidersSchema("Client authenticators which can run in an execution of a client flow"),
+		},
+	}
+}
+
+func authenticatorProvidersSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"config_properties": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"label": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"help_text": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"default_value": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"options": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"required": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// authenticatorConfigPropertyDefaultValue returns the default value of a config property as it would be set in the
+// config of an execution, where booleans are "true" or "false" and lists are JSON-encoded
+func authenticatorConfigPropertyDefaultValue(defaultValue interface{}) (string, error) {
+	switch value := defaultValue.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case bool, float64:
+		return fmt.Sprint(value), nil
+	default:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+
+		return string(encoded), nil
+	}
+}
+
+func getAuthenticatorProvidersData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, providers []*keycloak.AuthenticatorProvider) ([]interface{}, error) {
+	providersData := make([]interface{}, 0, len(providers))
+
+	for _, provider := range providers {
+		configPropertiesData := make([]interface{}, 0)
+
+		configDescription, err := keycloakClient.GetAuthenticatorConfigDescription(ctx, realmId, provider.Id)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return nil, err
+		}
+
+		if configDescription != nil {
+			for _, property := range configDescription.Properties {
+				defaultValue, err := authenticatorConfigPropertyDefaultValue(property.DefaultValue)
+				if err != nil {
+					return nil, err
+				}
+
+				configPropertiesData = append(configPropertiesData, map[string]interface{}{
+					"name":          property.Name,
+					"label":         property.Label,
+					"help_text":     property.HelpText,
+					"type":          property.Type,
+					"default_value": defaultValue,
+					"options":       property.Options,
+					"secret":        property.Secret,
+					"read_only":     property.ReadOnly,
+					"required":      property.Required,
+				})
+			}
+		}
+
+		providersData = append(providersData, map[string]interface{}{
+			"id":                provider.Id,
+			"display_name":      provider.DisplayName,
+			"description":       provider.Description,
+			"config_properties": configPropertiesData,
+		})
+	}
+
+	return providersData, nil
+}
+
+func dataSourceKeycloakAuthenticatorsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	providerLists := []struct {
+		attribute string
+		list      func(context.Context, string) ([]*keycloak.AuthenticatorProvider, error)
+	}{
+		{"authenticators", keycloakClient.ListAuthenticatorProviders},
+		{"form_actions", keycloakClient.ListFormActionProviders},
+		{"client_authenticators", keycloakClient.ListClientAuthenticatorProviders},
+	}
+
+	for _, providerList := range providerLists {
+		providers, err := providerList.list(ctx, realmId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		providersData, err := getAuthenticatorProvidersData(ctx, keycloakClient, realmId, providers)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = data.Set(providerList.attribute, providersData); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(realmId)
+
+	return nil
+}
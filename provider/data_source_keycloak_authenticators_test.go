@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakDataSourceAuthenticators_basic(t *testing.T) {
+	t.Parallel()
+
+	dataSourceName := "data.keycloak_authenticators.authenticators"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakAuthenticators_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "realm_id", testAccRealm.Realm),
+					testAccCheckDataKeycloakAuthenticatorsHasConfigProperty(dataSourceName, "authenticators", "identity-provider-redirector", "defaultProvider"),
+					testAccCheckDataKeycloakAuthenticatorsHasConfigProperty(dataSourceName, "form_actions", "registration-user-creation", ""),
+					testAccCheckDataKeycloakAuthenticatorsHasConfigProperty(dataSourceName, "client_authenticators", "client-secret", ""),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDataKeycloakAuthenticatorsHasConfigProperty checks that the provider is listed, with the given config
+// property unless it is empty
+func testAccCheckDataKeycloakAuthenticatorsHasConfigProperty(resourceName, attribute, providerId, property string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		attributes := rs.Primary.Attributes
+
+		for i := 0; attributes[fmt.Sprintf("%s.%d.id", attribute, i)] != ""; i++ {
+			if attributes[fmt.Sprintf("%s.%d.id", attribute, i)] != providerId {
+				continue
+			}
+
+			if property == "" {
+				return nil
+			}
+
+			for j := 0; attributes[fmt.Sprintf("%s.%d.config_properties.%d.name", attribute, i, j)] != ""; j++ {
+				if attributes[fmt.Sprintf("%s.%d.config_properties.%d.name", attribute, i, j)] == property {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("expected %s %s to have the config property %s", attribute, providerId, property)
+		}
+
+		return fmt.Errorf("expected %s to contain %s", attribute, providerId)
+	}
+}
+
+func testDataSourceKeycloakAuthenticators_basic() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_authenticators" "authenticators" {
+	realm_id = data.keycloak_realm.realm.id
+}
+	`, testAccRealm.Realm)
+}
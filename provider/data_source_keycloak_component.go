@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakComponent() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakComponentRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The id of the parent of the component, needed when components of different parents share the same name.",
+			},
+			"provider_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sub_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The config of the component, without the secret properties. Multiple values are separated by ##.",
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakComponentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	componentId, err := keycloakClient.GetComponentIdByName(ctx, realmId, data.Get("parent_id").(string), data.Get("provider_type").(string), data.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	component, err := keycloakClient.GetComponent(ctx, realmId, componentId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(component.Id)
+
+	data.Set("provider_id", component.ProviderId)
+	data.Set("parent_id", component.ParentId)
+	data.Set("sub_type", component.SubType)
+	data.Set("config", getComponentConfigData(component, nil, nil))
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceComponent_basic(t *testing.T) {
+	t.Parallel()
+
+	componentName := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_component.rsa"
+	resourceName := "keycloak_component.rsa"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakComponentDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakComponent_basic(componentName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "provider_id", resourceName, "provider_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "parent_id", resourceName, "parent_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "config.priority", "100"),
+					resource.TestCheckResourceAttr(dataSourceName, "config.keySize", "2048"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakComponent_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "keycloak_component" "rsa" {
+	realm_id      = keycloak_component.rsa.realm_id
+	name          = keycloak_component.rsa.name
+	provider_type = keycloak_component.rsa.provider_type
+}
+	`, testKeycloakComponent_rsaGenerated(name, "2048"))
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// identityProviderImportConfigAttributes maps the attributes of the data source to the config keys parsed by Keycloak
+var identityProviderImportConfigAttributes = map[string]string{
+	// saml
+	"idp_entity_id":              "idpEntityId",
+	"single_sign_on_service_url": "singleSignOnServiceUrl",
+	"single_logout_service_url":  "singleLogoutServiceUrl",
+	"signing_certificate":        "signingCertificate",
+	"name_id_policy_format":      "nameIDPolicyFormat",
+	// oidc
+	"issuer":            "issuer",
+	"authorization_url": "authorizationUrl",
+	"token_url":         "tokenUrl",
+	"logout_url":        "logoutUrl",
+	"user_info_url":     "userInfoUrl",
+	"jwks_url":          "jwksUrl",
+}
+
+func dataSourceKeycloakIdentityProviderImportConfig() *schema.Resource {
+	dataSourceSchema := map[string]*schema.Schema{
+		"realm": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Realm Name",
+		},
+		"provider_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The type of identity provider the document describes, such as saml or oidc.",
+		},
+		"from_url": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"from_url", "metadata"},
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "The URL of the SAML metadata or OpenID Connect discovery document, fetched by Keycloak.",
+		},
+		"metadata": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"from_url", "metadata"},
+			Description:  "The SAML metadata or OpenID Connect discovery document.",
+		},
+		"config": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "All the settings parsed from the document, keyed like the config of an identity provider.",
+		},
+	}
+
+	for attribute := range identityProviderImportConfigAttributes {
+		dataSourceSchema[attribute] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakIdentityProviderImportConfigRead,
+		Schema:      dataSourceSchema,
+	}
+}
+
+func dataSourceKeycloakIdentityProviderImportConfigRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+	providerId := data.Get("provider_id").(string)
+	fromUrl := data.Get("from_url").(string)
+	metadata := data.Get("metadata").(string)
+
+	var config keycloak.IdentityProviderImportConfig
+	var err error
+	if fromUrl != "" {
+		config, err = keycloakClient.ImportIdentityProviderConfigFromUrl(ctx, realm, providerId, fromUrl)
+	} else {
+		config, err = keycloakClient.ImportIdentityProviderConfigFromDocument(ctx, realm, providerId, metadata)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for attribute, configKey := range identityProviderImportConfigAttributes {
+		data.Set(attribute, config[configKey])
+	}
+	data.Set("config", map[string]string(config))
+
+	data.SetId(fmt.Sprintf("%s/%s", realm, providerId))
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakDataSourceIdentityProviderImportConfig_fromUrl(t *testing.T) {
+	t.Parallel()
+
+	realmUrl := regexp.QuoteMeta("/realms/" + testAccRealm.Realm)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakDataSourceIdentityProviderImportConfig_fromUrl(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.keycloak_identity_provider_import_config.oidc", "authorization_url", regexp.MustCompile(realmUrl+"/protocol/openid-connect/auth$")),
+					resource.TestMatchResourceAttr("data.keycloak_identity_provider_import_config.oidc", "issuer", regexp.MustCompile(realmUrl+"$")),
+					resource.TestMatchResourceAttr("data.keycloak_identity_provider_import_config.oidc", "config.tokenUrl", regexp.MustCompile(realmUrl+"/protocol/openid-connect/token$")),
+					resource.TestMatchResourceAttr("data.keycloak_identity_provider_import_config.saml", "single_sign_on_service_url", regexp.MustCompile(realmUrl+"/protocol/saml$")),
+					resource.TestCheckResourceAttrSet("data.keycloak_identity_provider_import_config.saml", "signing_certificate"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceIdentityProviderImportConfig_metadata(t *testing.T) {
+	t.Parallel()
+
+	response, err := http.Get(fmt.Sprintf("%s/realms/%s/protocol/saml/descriptor", os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	metadata, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakDataSourceIdentityProviderImportConfig_metadata(string(metadata)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.keycloak_identity_provider_import_config.saml", "single_sign_on_service_url", regexp.MustCompile(regexp.QuoteMeta("/realms/"+testAccRealm.Realm+"/protocol/saml")+"$")),
+					resource.TestCheckResourceAttrSet("data.keycloak_identity_provider_import_config.saml", "signing_certificate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakDataSourceIdentityProviderImportConfig_fromUrl() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_identity_provider_import_config" "oidc" {
+	realm       = data.keycloak_realm.realm.id
+	provider_id = "oidc"
+	from_url    = "%s/realms/%s/.well-known/openid-configuration"
+}
+
+data "keycloak_identity_provider_import_config" "saml" {
+	realm       = data.keycloak_realm.realm.id
+	provider_id = "saml"
+	from_url    = "%s/realms/%s/protocol/saml/descriptor"
+}
+	`, testAccRealm.Realm, os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm, os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm)
+}
+
+func testAccKeycloakDataSourceIdentityProviderImportConfig_metadata(metadata string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_identity_provider_import_config" "saml" {
+	realm       = data.keycloak_realm.realm.id
+	provider_id = "saml"
+	metadata    = <<-EOT
+%s
+EOT
+}
+	`, testAccRealm.Realm, metadata)
+}
@@ -42,6 +42,19 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"client_secret_expiration_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"client_secret_rotated": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_secret_rotated_expiration_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"client_authenticator_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -204,6 +217,26 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"federated_identity_provider": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"federated_subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"use_jwks_url": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"jwks_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tls_client_auth_subject_dn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"standard_token_exchange_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakOrganizationMembersRead,
+		Schema: map[string]*schema.Schema{
+			"realm": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Realm ID.",
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the members whose username, email, first or last name contain this value.",
+			},
+			"exact": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, search must match the username, email, first or last name exactly.",
+			},
+			"membership_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{keycloak.OrganizationMembershipTypeManaged, keycloak.OrganizationMembershipTypeUnmanaged}, false),
+			},
+			"first": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Index of the first member to return.",
+			},
+			"max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of members to return. All the members are returned when 0.",
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"membership_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakOrganizationMembersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+	organizationId := data.Get("organization_id").(string)
+
+	organizationMembers, err := keycloakClient.GetOrganizationMembers(ctx, realm, organizationId, &keycloak.OrganizationMembersQuery{
+		Search:         data.Get("search").(string),
+		Exact:          data.Get("exact").(bool),
+		MembershipType: data.Get("membership_type").(string),
+		First:          data.Get("first").(int),
+		Max:            data.Get("max").(int),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var members []interface{}
+	for _, member := range organizationMembers {
+		members = append(members, map[string]interface{}{
+			"user_id":         member.Id,
+			"username":        member.Username,
+			"email":           member.Email,
+			"first_name":      member.FirstName,
+			"last_name":       member.LastName,
+			"enabled":         member.Enabled,
+			"membership_type": member.MembershipType,
+		})
+	}
+
+	data.SetId(organizationMembersId(realm, organizationId))
+	data.Set("members", members)
+
+	return nil
+}
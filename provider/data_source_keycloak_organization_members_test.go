@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakDataSourceOrganizationMembers_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26)
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakDataSourceOrganizationMembers(organizationName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_organization_members.all", "members.#", "2"),
+					resource.TestCheckResourceAttr("data.keycloak_organization_members.page", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_organization_members.search", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_organization_members.search", "members.0.username", organizationName+"-two"),
+					resource.TestCheckResourceAttr("data.keycloak_organization_members.search", "members.0.membership_type", keycloak.OrganizationMembershipTypeUnmanaged),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeycloakDataSourceOrganizationMembers(organizationName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_organization_members" "members" {
+	realm           = data.keycloak_realm.realm.id
+	organization_id = keycloak_organization.organization.id
+
+	user_ids = [keycloak_user.user_one.id, keycloak_user.user_two.id]
+}
+
+data "keycloak_organization_members" "all" {
+	realm           = data.keycloak_realm.realm.id
+	organization_id = keycloak_organization_members.members.organization_id
+}
+
+data "keycloak_organization_members" "page" {
+	realm           = data.keycloak_realm.realm.id
+	organization_id = keycloak_organization_members.members.organization_id
+	first           = 1
+	max             = 1
+}
+
+data "keycloak_organization_members" "search" {
+	realm           = data.keycloak_realm.realm.id
+	organization_id = keycloak_organization_members.members.organization_id
+	search          = "%s-two"
+	exact           = true
+}
+	`, testKeycloakOrganizationMembers_users(organizationName), organizationName)
+}
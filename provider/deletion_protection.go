@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deletionProtectedResourceTypes lists the resources holding data that cannot be recovered once deleted
+var deletionProtectedResourceTypes = []string{
+	"keycloak_realm",
+	"keycloak_openid_client",
+	"keycloak_saml_client",
+	"keycloak_user",
+	"keycloak_group",
+	"keycloak_ldap_user_federation",
+	"keycloak_organization",
+}
+
+// providerDefaults holds the provider-level defaults of resource attributes, set when the provider is configured
+type providerDefaults struct {
+	deletionProtection bool
+}
+
+// addDeletionProtection adds the deletion_protection attribute to a resource. Replacing a protected resource fails at
+// plan time, and deleting it fails at apply time, as Terraform doesn't ask providers to plan the destruction of resources.
+func addDeletionProtection(resourceType string, resource *schema.Resource, defaults *providerDefaults) {
+	resource.Schema["deletion_protection"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "When true, the resource cannot be deleted or replaced. Defaults to the provider's deletion_protection.",
+	}
+
+	checkReplacement := func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if d.Id() == "" || !defaults.isDeletionProtected(d.GetRawState()) {
+			return nil
+		}
+
+		var forceNewAttributes []string
+		for attribute, attributeSchema := range resource.Schema {
+			if attributeSchema.ForceNew && d.HasChange(attribute) {
+				forceNewAttributes = append(forceNewAttributes, attribute)
+			}
+		}
+		if len(forceNewAttributes) == 0 {
+			return nil
+		}
+		sort.Strings(forceNewAttributes)
+
+		return fmt.Errorf("%s %s is protected against deletion, and changing %v replaces it. Set deletion_protection to false and apply the change before replacing this resource", resourceType, d.Id(), forceNewAttributes)
+	}
+
+	if resource.CustomizeDiff == nil {
+		resource.CustomizeDiff = checkReplacement
+	} else {
+		resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, checkReplacement)
+	}
+
+	deleteContext := resource.DeleteContext
+	resource.DeleteContext = func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if defaults.isDeletionProtected(data.GetRawState()) {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("%s %s is protected against deletion", resourceType, data.Id()),
+					Detail:   "Set deletion_protection to false and apply the change before destroying or replacing this resource.",
+				},
+			}
+		}
+
+		return deleteContext(ctx, data, meta)
+	}
+}
+
+// isDeletionProtected checks the protection in effect in the state, falling back to the provider default when
+// deletion_protection is not set. Objects left in place on destroy have nothing to protect.
+func (defaults *providerDefaults) isDeletionProtected(rawState cty.Value) bool {
+	if rawState.IsNull() || !rawState.IsKnown() || keepOnDestroy(rawState) {
+		return false
+	}
+
+	if deletionProtection := rawState.GetAttr("deletion_protection"); deletionProtection.IsKnown() && !deletionProtection.IsNull() {
+		return deletionProtection.True()
+	}
+
+	return defaults.deletionProtection
+}
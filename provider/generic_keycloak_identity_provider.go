@@ -110,6 +110,18 @@ func resourceKeycloakIdentityProvider() *schema.Resource {
 				Optional:    true,
 				Description: "ID of organization with which this identity is linked.",
 			},
+			"import": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Adopt an existing identity provider with the same alias instead of creating it. Adopted identity providers are left in place on destroy, unless keep_on_destroy is false.",
+			},
+			"keep_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Leave the identity provider in Keycloak when the resource is destroyed. Defaults to the value of import.",
+			},
 			// all schema values below this point will be configuration values that are shared among all identity providers
 			"extra_config": {
 				Type:             schema.TypeMap,
@@ -206,6 +218,10 @@ func setIdentityProviderData(data *schema.ResourceData, identityProvider *keyclo
 }
 
 func resourceKeycloakIdentityProviderDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if keepOnDestroy(data.GetRawState()) {
+		return nil
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realm := data.Get("realm").(string)
@@ -244,7 +260,30 @@ func resourceKeycloakIdentityProviderCreate(getIdentityProviderFromData identity
 		organization_id := identityProvider.OrganizationId
 		identityProvider.OrganizationId = ""
 
-		if err = keycloakClient.NewIdentityProvider(ctx, identityProvider); err != nil {
+		if data.Get("import").(bool) {
+			existingIdentityProvider, err := keycloakClient.GetIdentityProvider(ctx, identityProvider.Realm, identityProvider.Alias)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			identityProvider.InternalId = existingIdentityProvider.InternalId
+			identityProvider.OrganizationId = existingIdentityProvider.OrganizationId
+
+			if existingIdentityProvider.OrganizationId != "" && existingIdentityProvider.OrganizationId != organization_id {
+				err = keycloakClient.UnlinkIdentityProviderFromOrganization(ctx, identityProvider.Realm, identityProvider.Alias, existingIdentityProvider.OrganizationId)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				identityProvider.OrganizationId = ""
+			}
+
+			if err = keycloakClient.UpdateIdentityProvider(ctx, identityProvider); err != nil {
+				return diag.FromErr(err)
+			}
+			if identityProvider.OrganizationId == organization_id {
+				organization_id = ""
+			}
+		} else if err = keycloakClient.NewIdentityProvider(ctx, identityProvider); err != nil {
 			return diag.FromErr(err)
 		}
 		if err = setDataFromIdentityProvider(data, identityProvider, keycloakVersion); err != nil {
@@ -285,6 +324,10 @@ func resourceKeycloakIdentityProviderRead(setDataFromIdentityProvider identityPr
 			return handleNotFoundError(ctx, err, data)
 		}
 
+		if _, ok := data.GetOk("import"); !ok {
+			data.Set("import", false)
+		}
+
 		return diag.FromErr(setDataFromIdentityProvider(data, identityProvider, keycloakVersion))
 	}
 }
@@ -3,13 +3,29 @@ package provider
 import (
 	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
+const (
+	identityProviderMapperSyncModeKey = "syncMode"
+
+	// config keys of the advanced mappers, matching several claims or attributes
+	advancedIdentityProviderMapperClaimsKey               = "claims"
+	advancedIdentityProviderMapperClaimValuesRegexKey     = "are.claim.values.regex"
+	advancedIdentityProviderMapperAttributesKey           = "attributes"
+	advancedIdentityProviderMapperAttributeValuesRegexKey = "are.attribute.values.regex"
+)
+
+// identityProviderMapperSyncModes are the sync modes of a mapper, which may also inherit the sync mode of its identity provider
+var identityProviderMapperSyncModes = append([]string{"INHERIT"}, syncModes...)
+
 type identityProviderMapperDataGetterFunc func(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error)
 type identityProviderMapperDataSetterFunc func(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error
 
@@ -46,6 +62,135 @@ func resourceKeycloakIdentityProviderMapper() *schema.Resource {
 	}
 }
 
+// identityProviderMapperSyncModeSchema is the sync_mode attribute of the typed mappers, stored in the syncMode key of the mapper config
+func identityProviderMapperSyncModeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "INHERIT",
+		ValidateFunc: validation.StringInSlice(identityProviderMapperSyncModes, false),
+		Description:  "Sync Mode",
+	}
+}
+
+// identityProviderMapperExtraConfigSchema is the extra_config attribute of the typed mappers, which rejects the config keys
+// managed by top-level attributes
+func identityProviderMapperExtraConfigSchema(configKeys ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		ValidateDiagFunc: func(v interface{}, path cty.Path) diag.Diagnostics {
+			var diags diag.Diagnostics
+
+			extraConfig := v.(map[string]interface{})
+			for _, configKey := range append([]string{identityProviderMapperSyncModeKey}, configKeys...) {
+				if _, ok := extraConfig[configKey]; ok {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Invalid extra_config key",
+						Detail:   fmt.Sprintf(`extra_config key "%s" is not allowed, as it conflicts with a top-level schema attribute`, configKey),
+						AttributePath: append(path, cty.IndexStep{
+							Key: cty.StringVal(configKey),
+						}),
+					})
+				}
+			}
+
+			return diags
+		},
+	}
+}
+
+func getIdentityProviderMapperSyncModeFromData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) {
+	identityProviderMapper.Config.ExtraConfig[identityProviderMapperSyncModeKey] = data.Get("sync_mode").(string)
+}
+
+func setIdentityProviderMapperSyncModeData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) {
+	syncMode, _ := identityProviderMapper.Config.ExtraConfig[identityProviderMapperSyncModeKey].(string)
+	data.Set("sync_mode", syncMode)
+}
+
+// parseIdentityProviderMapperBool reads a boolean which is not part of the typed mapper config, treating a missing value as false
+func parseIdentityProviderMapperBool(identityProviderMapper *keycloak.IdentityProviderMapper, configKey string) (bool, error) {
+	value, _ := identityProviderMapper.Config.ExtraConfig[configKey].(string)
+	if value == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
+// getIdentityProviderMapperKeyValuesFromData reads a list of key/value blocks, such as the claims matched by an advanced mapper
+func getIdentityProviderMapperKeyValuesFromData(data *schema.ResourceData, attributeName string) []keycloak.IdentityProviderMapperKeyValue {
+	var keyValues []keycloak.IdentityProviderMapperKeyValue
+
+	for _, v := range data.Get(attributeName).([]interface{}) {
+		keyValue := v.(map[string]interface{})
+		keyValues = append(keyValues, keycloak.IdentityProviderMapperKeyValue{
+			Key:   keyValue["key"].(string),
+			Value: keyValue["value"].(string),
+		})
+	}
+
+	return keyValues
+}
+
+func setIdentityProviderMapperKeyValuesData(data *schema.ResourceData, attributeName string, keyValues []keycloak.IdentityProviderMapperKeyValue) {
+	var values []interface{}
+
+	for _, keyValue := range keyValues {
+		values = append(values, map[string]interface{}{
+			"key":   keyValue.Key,
+			"value": keyValue.Value,
+		})
+	}
+
+	data.Set(attributeName, values)
+}
+
+func identityProviderMapperKeyValuesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// getIdentityProviderMapperIdentityProvider fetches the identity provider of a mapper and checks that the mapper supports it
+func getIdentityProviderMapperIdentityProvider(ctx context.Context, keycloakClient *keycloak.KeycloakClient, resourceName string, identityProviderMapper *keycloak.IdentityProviderMapper, supportedProvider func(providerId string) bool) (*keycloak.IdentityProvider, error) {
+	identityProvider, err := keycloakClient.GetIdentityProvider(ctx, identityProviderMapper.Realm, identityProviderMapper.IdentityProviderAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	if !supportedProvider(identityProvider.ProviderId) {
+		return nil, fmt.Errorf(`provider.keycloak: %s: %s: "%s" identity provider is not supported`, resourceName, identityProviderMapper.Name, identityProvider.ProviderId)
+	}
+
+	return identityProvider, nil
+}
+
+func isOidcIdentityProvider(providerId string) bool {
+	return providerId == "oidc" || providerId == "keycloak-oidc"
+}
+
+func isSamlIdentityProvider(providerId string) bool {
+	return providerId == "saml"
+}
+
 func getIdentityProviderMapperFromData(data *schema.ResourceData) (*keycloak.IdentityProviderMapper, error) {
 	rec := &keycloak.IdentityProviderMapper{
 		Id:                    data.Id(),
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+)
+
+// socialIdentityProviderIds lists the social identity providers built into Keycloak
+var socialIdentityProviderIds = []string{
+	"bitbucket",
+	"facebook",
+	"github",
+	"gitlab",
+	"google",
+	"instagram",
+	"linkedin-openid-connect",
+	"microsoft",
+	"paypal",
+	"stackoverflow",
+	"twitter",
+}
+
+func isSocialIdentityProvider(providerId string) bool {
+	return slices.Contains(socialIdentityProviderIds, providerId)
+}
+
+// isSocialIdentityProviderWithJsonUserAttributes tells whether a social identity provider has a <providerId>-user-attribute-mapper,
+// importing user attributes from the JSON user profile returned by the provider
+func isSocialIdentityProviderWithJsonUserAttributes(providerId string) bool {
+	return slices.Contains([]string{"bitbucket", "facebook", "github", "google", "instagram", "microsoft", "paypal", "stackoverflow"}, providerId)
+}
+
+// socialIdentityProvider describes a social identity provider built into Keycloak, such as github or microsoft
+type socialIdentityProvider struct {
+	providerId string
+	// schema of the options specific to this provider
+	schema map[string]*schema.Schema
+	// configKeys maps the options specific to this provider to their identity provider config key
+	configKeys map[string]string
+	// getConfig and setData handle the options stored in a field of the identity provider config, if any
+	getConfig func(data *schema.ResourceData, config *keycloak.IdentityProviderConfig)
+	setData   func(data *schema.ResourceData, config *keycloak.IdentityProviderConfig)
+}
+
+// resourceKeycloakSocialIdentityProvider builds the resource of a social identity provider. The options specific to
+// the provider are stored in the extra config of the identity provider, next to the client id and secret.
+func resourceKeycloakSocialIdentityProvider(socialProvider *socialIdentityProvider) *schema.Resource {
+	socialSchema := map[string]*schema.Schema{
+		"alias": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The alias uniquely identifies an identity provider and it is also used to build the redirect uri. Defaults to %s.", socialProvider.providerId),
+		},
+		"provider_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     socialProvider.providerId,
+			ForceNew:    true,
+			Description: fmt.Sprintf("provider id, is always %s, unless you have a extended custom implementation", socialProvider.providerId),
+		},
+		"client_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Client ID.",
+		},
+		"client_secret": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Client Secret.",
+		},
+		"default_scopes": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "The scopes to be sent when asking for authorization. The scopes of the provider are used when empty.",
+		},
+		"hide_on_login_page": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Hide On Login Page.",
+		},
+	}
+
+	resource := resourceKeycloakIdentityProvider()
+	resource.Schema = mergeSchemas(mergeSchemas(resource.Schema, socialSchema), socialProvider.schema)
+	resource.Schema["extra_config"].ValidateDiagFunc = validateSocialIdentityProviderExtraConfig(socialProvider)
+
+	getter := socialProvider.getIdentityProviderFromData
+	setter := socialProvider.setIdentityProviderData
+	resource.CreateContext = socialProvider.validate(resourceKeycloakIdentityProviderCreate(getter, setter))
+	resource.ReadContext = resourceKeycloakIdentityProviderRead(setter)
+	resource.UpdateContext = socialProvider.validate(resourceKeycloakIdentityProviderUpdate(getter, setter))
+
+	return resource
+}
+
+// validate makes an operation fail when the provider is not installed on the server
+func (socialProvider *socialIdentityProvider) validate(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		if err := keycloakClient.ValidateSocialIdentityProvider(ctx, data.Get("provider_id").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return operation(ctx, data, meta)
+	}
+}
+
+func (socialProvider *socialIdentityProvider) getIdentityProviderFromData(data *schema.ResourceData, keycloakVersion *version.Version) (*keycloak.IdentityProvider, error) {
+	rec, config := getIdentityProviderFromData(data, keycloakVersion)
+	rec.ProviderId = data.Get("provider_id").(string)
+
+	if alias, ok := data.GetOk("alias"); ok {
+		rec.Alias = alias.(string)
+	} else {
+		rec.Alias = socialProvider.providerId
+	}
+
+	config.ClientId = data.Get("client_id").(string)
+	config.ClientSecret = data.Get("client_secret").(string)
+	config.DefaultScope = data.Get("default_scopes").(string)
+
+	//since keycloak v26 moved to IdentityProvider - still here fore backward compatibility
+	config.HideOnLoginPage = types.KeycloakBoolQuoted(data.Get("hide_on_login_page").(bool))
+
+	for attribute, configKey := range socialProvider.configKeys {
+		switch value := data.Get(attribute).(type) {
+		case bool:
+			config.ExtraConfig[configKey] = types.KeycloakBoolQuoted(value)
+		default:
+			config.ExtraConfig[configKey] = value
+		}
+	}
+
+	if socialProvider.getConfig != nil {
+		socialProvider.getConfig(data, config)
+	}
+
+	rec.Config = config
+
+	return rec, nil
+}
+
+func (socialProvider *socialIdentityProvider) setIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, keycloakVersion *version.Version) error {
+	setIdentityProviderData(data, identityProvider, keycloakVersion)
+	data.Set("provider_id", identityProvider.ProviderId)
+	data.Set("client_id", identityProvider.Config.ClientId)
+	data.Set("default_scopes", identityProvider.Config.DefaultScope)
+
+	for attribute, configKey := range socialProvider.configKeys {
+		value, ok := identityProvider.Config.ExtraConfig[configKey]
+		if !ok {
+			data.Set(attribute, nil)
+			continue
+		}
+
+		if socialProvider.schema[attribute].Type == schema.TypeBool {
+			boolValue, _ := strconv.ParseBool(fmt.Sprint(value))
+			data.Set(attribute, boolValue)
+		} else {
+			data.Set(attribute, value)
+		}
+	}
+
+	if socialProvider.setData != nil {
+		socialProvider.setData(data, identityProvider.Config)
+	}
+
+	if keycloakVersion.LessThan(keycloak.Version_26.AsVersion()) {
+		// Since keycloak v26 the attribute "hideOnLoginPage" is not part of the identity provider config anymore!
+		data.Set("hide_on_login_page", identityProvider.Config.HideOnLoginPage)
+	}
+
+	return nil
+}
+
+// validateSocialIdentityProviderExtraConfig prevents extra_config from holding the options specific to the provider
+func validateSocialIdentityProviderExtraConfig(socialProvider *socialIdentityProvider) schema.SchemaValidateDiagFunc {
+	validateConfig := validateExtraConfig(reflect.ValueOf(&keycloak.IdentityProviderConfig{}).Elem())
+
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		diags := validateConfig(v, path)
+
+		extraConfig := v.(map[string]interface{})
+		for attribute, configKey := range socialProvider.configKeys {
+			if _, ok := extraConfig[configKey]; ok {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid extra_config key",
+					Detail:   fmt.Sprintf(`extra_config key "%s" is not allowed, use the %s attribute instead`, configKey, attribute),
+					AttributePath: append(path, cty.IndexStep{
+						Key: cty.StringVal(configKey),
+					}),
+				})
+			}
+		}
+
+		return diags
+	}
+}
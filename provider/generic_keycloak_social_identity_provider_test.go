@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+/*
+	note: social identity providers are created with a random alias, so several instances of the same provider can
+	exist in the test realm.
+*/
+
+func TestAccKeycloakSocialIdentityProvider_basic(t *testing.T) {
+	// instagram is left out, it is disabled by default since Keycloak 22
+	for _, socialProvider := range []string{"gitlab", "facebook", "linkedin", "bitbucket", "twitter"} {
+		t.Run(socialProvider, func(t *testing.T) {
+			resourceType := fmt.Sprintf("keycloak_%s_identity_provider", socialProvider)
+			resourceName := resourceType + ".social"
+			alias := acctest.RandomWithPrefix("tf-acc")
+
+			resource.Test(t, resource.TestCase{
+				ProviderFactories: testAccProviderFactories,
+				PreCheck:          func() { testAccPreCheck(t) },
+				CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy(resourceType),
+				Steps: []resource.TestStep{
+					{
+						Config: testKeycloakSocialIdentityProvider_basic(resourceType, alias, ""),
+						Check:  testAccCheckKeycloakSocialIdentityProviderExists(resourceName),
+					},
+					{
+						ResourceName:            resourceName,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateId:           testAccRealm.Realm + "/" + alias,
+						ImportStateVerifyIgnore: []string{"client_secret"},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccKeycloakSocialIdentityProvider_notInstalled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_github_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSocialIdentityProvider_basic("keycloak_github_identity_provider", acctest.RandomWithPrefix("tf-acc"), `provider_id = "not-installed"`),
+				ExpectError: regexp.MustCompile(`social identity provider "not-installed" does not exist on the server`),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakSocialIdentityProviderExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakOidcGoogleIdentityProviderFromState(s, resourceName)
+
+		return err
+	}
+}
+
+// testAccCheckKeycloakSocialIdentityProviderHasConfigValue checks an option stored in the extra config of the identity provider
+func testAccCheckKeycloakSocialIdentityProviderHasConfigValue(resourceName, configKey, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		idp, err := getKeycloakOidcGoogleIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		actual, _ := idp.Config.ExtraConfig[configKey].(string)
+		if actual != value {
+			return fmt.Errorf("expected identity provider %s to have %s %s, got %s", idp.Alias, configKey, value, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakSocialIdentityProviderDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm"]
+
+			idp, _ := keycloakClient.GetIdentityProvider(testCtx, realm, id)
+			if idp != nil {
+				return fmt.Errorf("%s with id %s still exists", resourceType, id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSocialIdentityProvider_basic(resourceType, alias, options string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "%s" "social" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	client_id     = "example_id"
+	client_secret = "example_token"
+
+	%s
+}
+	`, testAccRealm.Realm, resourceType, alias, options)
+}
@@ -353,6 +353,54 @@ func TestAccKeycloakOpenIdUserSessionNoteProtocolMapper_clientScopeDuplicateName
 	})
 }
 
+func TestAccKeycloakSamlRoleListProtocolMapper_clientDuplicateNameValidation(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	groupMembershipProtocolMapperResourceName := "keycloak_saml_group_membership_protocol_mapper.saml_group_membership_mapper_client"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGenericProtocolMapperValidation_clientSamlGroupMembershipMapper(clientId, mapperName),
+				Check:  testKeycloakSamlGroupMembershipProtocolMapperExists(groupMembershipProtocolMapperResourceName),
+			},
+			{
+				Config:      testGenericProtocolMapperValidation_clientSamlGroupMembershipAndRoleListMapper(clientId, mapperName),
+				ExpectError: regexp.MustCompile("validation error: a protocol mapper with name .+ already exists for this client"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_clientScopeDuplicateNameValidation(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	audienceProtocolMapperResourceName := "keycloak_saml_audience_protocol_mapper.saml_audience_mapper_client_scope"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlAudienceProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGenericProtocolMapperValidation_clientScopeSamlAudienceMapper(clientScopeName, mapperName),
+				Check:  testKeycloakSamlAudienceProtocolMapperExists(audienceProtocolMapperResourceName),
+			},
+			{
+				Config:      testGenericProtocolMapperValidation_clientScopeSamlAudienceAndHardcodedAttributeMapper(clientScopeName, mapperName),
+				ExpectError: regexp.MustCompile("validation error: a protocol mapper with name .+ already exists for this client"),
+			},
+		},
+	})
+}
+
 /*
  * Protocol mappers must be attached to either a client or client scope.  The following tests assert that errors are raised
  * if neither are specified.
@@ -486,6 +534,102 @@ func TestAccKeycloakOpenIdUserSessionNoteProtocolMapper_validateClientOrClientSc
 	})
 }
 
+func TestAccKeycloakSamlRoleListProtocolMapper_validateClientOrClientScopeSet(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlRoleListProtocolMapper_parentResourceValidation(mapperName),
+				ExpectError: regexp.MustCompile("validation error: one of ClientId or ClientScopeId must be set"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_validateClientOrClientScopeSet(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlGroupMembershipProtocolMapper_parentResourceValidation(mapperName),
+				ExpectError: regexp.MustCompile("validation error: one of ClientId or ClientScopeId must be set"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_validateClientOrClientScopeSet(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlHardcodedAttributeProtocolMapper_parentResourceValidation(mapperName),
+				ExpectError: regexp.MustCompile("validation error: one of ClientId or ClientScopeId must be set"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedRoleProtocolMapper_validateClientOrClientScopeSet(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlHardcodedRoleProtocolMapper_parentResourceValidation(mapperName),
+				ExpectError: regexp.MustCompile("validation error: one of ClientId or ClientScopeId must be set"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlRoleNameProtocolMapper_validateClientOrClientScopeSet(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlRoleNameProtocolMapper_parentResourceValidation(mapperName),
+				ExpectError: regexp.MustCompile("validation error: one of ClientId or ClientScopeId must be set"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlAudienceProtocolMapper_validateClientOrClientScopeSet(t *testing.T) {
+	t.Parallel()
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlAudienceProtocolMapper_parentResourceValidation(mapperName),
+				ExpectError: regexp.MustCompile("validation error: one of ClientId or ClientScopeId must be set"),
+			},
+		},
+	})
+}
+
 func testGenericProtocolMapperValidation_clientGroupMembershipMapper(clientId, mapperName string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -1147,3 +1291,181 @@ resource "keycloak_openid_user_session_note_protocol_mapper" "user_session_note_
 	claim_value_type = "String"
 }`, testAccRealm.Realm, mapperName)
 }
+
+func testGenericProtocolMapperValidation_clientSamlGroupMembershipMapper(clientId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "saml_group_membership_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	group_attribute_name = "member"
+}`, testAccRealm.Realm, clientId, mapperName)
+}
+
+func testGenericProtocolMapperValidation_clientSamlGroupMembershipAndRoleListMapper(clientId, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "saml_group_membership_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	group_attribute_name = "member"
+}
+
+resource "keycloak_saml_role_list_protocol_mapper" "saml_role_list_mapper_client" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+}`, testAccRealm.Realm, clientId, mapperName, mapperName)
+}
+
+func testGenericProtocolMapperValidation_clientScopeSamlAudienceMapper(clientScopeName, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client_scope" "saml_client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_audience_protocol_mapper" "saml_audience_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.saml_client_scope.id
+
+	included_custom_audience = "foo"
+}`, testAccRealm.Realm, clientScopeName, mapperName)
+}
+
+func testGenericProtocolMapperValidation_clientScopeSamlAudienceAndHardcodedAttributeMapper(clientScopeName, mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client_scope" "saml_client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_audience_protocol_mapper" "saml_audience_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.saml_client_scope.id
+
+	included_custom_audience = "foo"
+}
+
+resource "keycloak_saml_hardcoded_attribute_protocol_mapper" "saml_hardcoded_attribute_mapper_client_scope" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.saml_client_scope.id
+
+	saml_attribute_name  = "foo"
+	saml_attribute_value = "bar"
+}`, testAccRealm.Realm, clientScopeName, mapperName, mapperName)
+}
+
+func testKeycloakSamlRoleListProtocolMapper_parentResourceValidation(mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_role_list_protocol_mapper" "saml_role_list_mapper" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+}`, testAccRealm.Realm, mapperName)
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapper_parentResourceValidation(mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "saml_group_membership_mapper" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	group_attribute_name = "member"
+}`, testAccRealm.Realm, mapperName)
+}
+
+func testKeycloakSamlHardcodedAttributeProtocolMapper_parentResourceValidation(mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_hardcoded_attribute_protocol_mapper" "saml_hardcoded_attribute_mapper" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	saml_attribute_name = "foo"
+}`, testAccRealm.Realm, mapperName)
+}
+
+func testKeycloakSamlHardcodedRoleProtocolMapper_parentResourceValidation(mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_hardcoded_role_protocol_mapper" "saml_hardcoded_role_mapper" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	role_id = "foo"
+}`, testAccRealm.Realm, mapperName)
+}
+
+func testKeycloakSamlRoleNameProtocolMapper_parentResourceValidation(mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_role_name_protocol_mapper" "saml_role_name_mapper" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	role_id       = "foo"
+	new_role_name = "bar"
+}`, testAccRealm.Realm, mapperName)
+}
+
+func testKeycloakSamlAudienceProtocolMapper_parentResourceValidation(mapperName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_audience_protocol_mapper" "saml_audience_mapper" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	included_custom_audience = "foo"
+}`, testAccRealm.Realm, mapperName)
+}
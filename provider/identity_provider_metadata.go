@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// metadataUrlConfigKey is the identity provider config key Keycloak keeps the URL of the metadata or discovery document in
+const metadataUrlConfigKey = "metadataDescriptorUrl"
+
+// identityProviderMetadata describes the attributes of an identity provider resource which are imported from a SAML
+// metadata or OpenID Connect discovery document when metadata_url is set
+type identityProviderMetadata struct {
+	// attributes maps the imported attributes to the config keys parsed by Keycloak
+	attributes map[string]string
+	// required lists the imported attributes which must be set when metadata_url is not
+	required []string
+}
+
+// addMetadataUrl adds metadata_url to an identity provider resource. The imported attributes become computed, they are
+// imported again on every plan so that changes of the document, such as certificate rotations, show as diffs.
+func (metadata *identityProviderMetadata) addMetadataUrl(resource *schema.Resource) {
+	resource.Schema["metadata_url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		Description:  "The URL of the metadata or discovery document the endpoints and keys of the identity provider are imported from.",
+	}
+
+	for attribute := range metadata.attributes {
+		attributeSchema := resource.Schema[attribute]
+		attributeSchema.Required = false
+		attributeSchema.Optional = true
+		attributeSchema.Computed = true
+		attributeSchema.Default = nil
+
+		if slices.Contains(metadata.required, attribute) {
+			attributeSchema.ExactlyOneOf = []string{attribute, "metadata_url"}
+		} else {
+			attributeSchema.ConflictsWith = []string{"metadata_url"}
+		}
+	}
+
+	resource.CustomizeDiff = metadata.customizeDiff
+	resource.CreateContext = metadata.importConfig(resource.CreateContext)
+	resource.UpdateContext = metadata.importConfig(resource.UpdateContext)
+}
+
+func (metadata *identityProviderMetadata) customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// only the attributes left out of the configuration are imported
+	var importedAttributes []string
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+	for attribute := range metadata.attributes {
+		if rawConfig.GetAttr(attribute).IsNull() {
+			importedAttributes = append(importedAttributes, attribute)
+		}
+	}
+
+	if !d.NewValueKnown("metadata_url") || !d.NewValueKnown("realm") {
+		for _, attribute := range importedAttributes {
+			if err := d.SetNewComputed(attribute); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	config := keycloak.IdentityProviderImportConfig{}
+	if metadataUrl := d.Get("metadata_url").(string); metadataUrl != "" {
+		var err error
+		config, err = keycloakClient.ImportIdentityProviderConfigFromUrl(ctx, d.Get("realm").(string), d.Get("provider_id").(string), metadataUrl)
+		if keycloak.ErrorIs404(err) {
+			// the realm is created along with the identity provider, the attributes are imported when it is applied
+			for _, attribute := range importedAttributes {
+				if err := d.SetNewComputed(attribute); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error importing the config of the identity provider from %s: %s", metadataUrl, err)
+		}
+	}
+
+	// without metadata_url, the attributes removed from the configuration are cleared like optional attributes are
+	for _, attribute := range importedAttributes {
+		configKey := metadata.attributes[attribute]
+		if d.Get(attribute).(string) != config[configKey] {
+			if err := d.SetNew(attribute, config[configKey]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// importConfig sets the imported attributes from the metadata or discovery document before an operation
+func (metadata *identityProviderMetadata) importConfig(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		if metadataUrl := data.Get("metadata_url").(string); metadataUrl != "" {
+			config, err := keycloakClient.ImportIdentityProviderConfigFromUrl(ctx, data.Get("realm").(string), data.Get("provider_id").(string), metadataUrl)
+			if err != nil {
+				return diag.Errorf("error importing the config of the identity provider from %s: %s", metadataUrl, err)
+			}
+
+			for attribute, configKey := range metadata.attributes {
+				data.Set(attribute, config[configKey])
+			}
+		}
+
+		return operation(ctx, data, meta)
+	}
+}
+
+func getMetadataUrlFromData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	if metadataUrl := data.Get("metadata_url").(string); metadataUrl != "" {
+		config.ExtraConfig[metadataUrlConfigKey] = metadataUrl
+	}
+}
+
+func setMetadataUrlData(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+	// the config key may also be managed through extra_config
+	if _, ok := getExtraConfigFromData(data)[metadataUrlConfigKey]; ok {
+		return
+	}
+
+	metadataUrl, _ := config.ExtraConfig[metadataUrlConfigKey].(string)
+	data.Set("metadata_url", metadataUrl)
+}
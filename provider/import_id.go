@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// importIdFormat is a format of import ID made of natural keys, such as {{realm_id}}/{{client_id}}/{{name}}. Its
+// placeholders are named after the attributes of the resource identity, and are resolved through the Keycloak API
+// into the import ID understood by the importer. Placeholders which are not attributes of the identity, such as the
+// bind credentials of an LDAP user federation, are appended as is to the resolved import ID.
+type importIdFormat struct {
+	format string
+	// when set, the last part of the format captures the rest of the import ID, slashes included (used for group paths)
+	greedy bool
+}
+
+// match returns the parts of the import ID by placeholder name, or false if the import ID does not have this format.
+// Segments of the format which are not placeholders must be present as is in the import ID.
+func (f importIdFormat) match(importId string) (map[string]string, bool) {
+	segments := strings.Split(f.format, "/")
+	values := strings.Split(importId, "/")
+
+	if f.greedy && len(values) > len(segments) {
+		last := len(segments) - 1
+		values = append(values[:last], strings.Join(values[last:], "/"))
+	}
+
+	if len(values) != len(segments) {
+		return nil, false
+	}
+
+	parts := map[string]string{}
+	for i, segment := range segments {
+		if placeholder, ok := importIdPlaceholder(segment); ok {
+			if values[i] == "" {
+				return nil, false
+			}
+			parts[placeholder] = values[i]
+		} else if segment != values[i] {
+			return nil, false
+		}
+	}
+
+	return parts, true
+}
+
+// placeholders returns the names of the placeholders of the format, in order
+func (f importIdFormat) placeholders() []string {
+	var placeholders []string
+	for _, segment := range strings.Split(f.format, "/") {
+		if placeholder, ok := importIdPlaceholder(segment); ok {
+			placeholders = append(placeholders, placeholder)
+		}
+	}
+
+	return placeholders
+}
+
+func importIdPlaceholder(segment string) (string, bool) {
+	if !strings.HasPrefix(segment, "{{") || !strings.HasSuffix(segment, "}}") {
+		return "", false
+	}
+
+	return strings.TrimSuffix(strings.TrimPrefix(segment, "{{"), "}}"), true
+}
+
+// naturalKeyNotFoundError is returned when a natural key of an import ID doesn't match anything. The lookups by name
+// of the Keycloak client report that nothing has the name with errors of their own, and failed requests with API
+// errors, so any error other than an API error, or a 404, means that the natural key was not found.
+type naturalKeyNotFoundError struct {
+	err error
+}
+
+func (e *naturalKeyNotFoundError) Error() string {
+	return e.err.Error()
+}
+
+func (e *naturalKeyNotFoundError) Unwrap() error {
+	return e.err
+}
+
+func naturalKeyLookupError(err error) error {
+	var apiError *keycloak.ApiError
+	if errors.As(err, &apiError) && apiError.Code != http.StatusNotFound {
+		return err
+	}
+
+	return &naturalKeyNotFoundError{err: err}
+}
+
+// naturalKeyImportIdFormats returns the formats of the import IDs made of natural keys accepted by the resource
+func (r *resourceIdentity) naturalKeyImportIdFormats() []importIdFormat {
+	if len(r.importIdFormats) != 0 {
+		return r.importIdFormats
+	}
+
+	placeholders := make([]string, 0, len(r.required))
+	for _, attribute := range r.required {
+		placeholders = append(placeholders, "{{"+attribute+"}}")
+	}
+
+	return []importIdFormat{{format: strings.Join(placeholders, "/")}}
+}
+
+// parseImportId resolves an import ID using the first format made of natural keys it matches. An import ID of internal
+// ids can have the same shape, so when a natural key is not found, the next format is tried, and the import ID is
+// eventually passed as is to the importer, along with the error to report if it is not made of internal ids either.
+// Other errors of the natural key lookups, such as a 403, are returned right away.
+func (r *resourceIdentity) parseImportId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, importId string) (string, error, error) {
+	var notFoundErr error
+
+	for _, format := range r.naturalKeyImportIdFormats() {
+		parts, ok := format.match(importId)
+		if !ok {
+			continue
+		}
+
+		identity := map[string]string{}
+		var extraParts []string
+		for _, placeholder := range format.placeholders() {
+			if slices.Contains(r.required, placeholder) || slices.Contains(r.optional, placeholder) {
+				identity[placeholder] = parts[placeholder]
+			} else {
+				extraParts = append(extraParts, parts[placeholder])
+			}
+		}
+
+		resolvedId, err := r.importId(ctx, keycloakClient, identity)
+		if err == nil {
+			return strings.Join(append([]string{resolvedId}, extraParts...), "/"), nil, nil
+		}
+
+		err = naturalKeyLookupError(err)
+
+		var notFound *naturalKeyNotFoundError
+		if !errors.As(err, &notFound) {
+			return "", nil, err
+		}
+
+		notFoundErr = err
+	}
+
+	return importId, notFoundErr, nil
+}
+
+func (r *resourceIdentity) invalidImportIdError(notFoundErr error) error {
+	var supportedFormats []string
+	for _, format := range r.naturalKeyImportIdFormats() {
+		supportedFormats = append(supportedFormats, format.format)
+	}
+
+	return fmt.Errorf("Invalid import. Supported import formats: %s, or the import ID of the resource: %s", strings.Join(supportedFormats, ", "), notFoundErr)
+}
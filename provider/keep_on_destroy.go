@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// keepOnDestroy returns whether the object managed by a resource must be left in Keycloak when the resource is
+// destroyed. It is set by the keep_on_destroy attribute, which defaults to the import attribute, so adopted objects are
+// left in place unless asked otherwise.
+func keepOnDestroy(rawState cty.Value) bool {
+	if rawState.IsNull() || !rawState.IsKnown() {
+		return false
+	}
+
+	for _, attribute := range []string{"keep_on_destroy", "import"} {
+		if !rawState.Type().HasAttribute(attribute) {
+			continue
+		}
+
+		if value := rawState.GetAttr(attribute); value.IsKnown() && !value.IsNull() {
+			return value.True()
+		}
+	}
+
+	return false
+}
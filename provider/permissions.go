@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
@@ -88,3 +90,63 @@ func scopePermissionsSchema() *schema.Schema {
 		},
 	}
 }
+
+// adminPermissionsV2Enabled returns whether a realm uses fine-grained admin permissions v2, which replace the v1
+// permissions toggled on each user, group or client
+func adminPermissionsV2Enabled(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string) (bool, error) {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_26_2)
+	if err != nil || !versionOk {
+		return false, err
+	}
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return false, err
+	}
+
+	return realm.AdminPermissionsEnabled != nil && *realm.AdminPermissionsEnabled, nil
+}
+
+// checkAdminPermissionsV1 fails when the realm uses fine-grained admin permissions v2, as Keycloak rejects the v1
+// permissions of the given resource type on such realms
+func checkAdminPermissionsV1(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, resourceType string) error {
+	v2Enabled, err := adminPermissionsV2Enabled(ctx, keycloakClient, realmId)
+	if err != nil {
+		return err
+	}
+
+	if v2Enabled {
+		return fmt.Errorf("realm %s has admin_permissions_enabled, so its fine-grained admin permissions must be managed with keycloak_admin_permission and the %s resource type instead", realmId, resourceType)
+	}
+
+	return nil
+}
+
+// getAdminPermissionsResourceServerId returns the id of the admin-permissions client of a realm, which holds its
+// fine-grained admin permissions v2 and their policies
+func getAdminPermissionsResourceServerId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string) (string, error) {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_26_2)
+	if err != nil {
+		return "", err
+	}
+
+	if !versionOk {
+		return "", fmt.Errorf("fine-grained admin permissions v2 require Keycloak 26.2 or later, use keycloak_users_permissions, keycloak_group_permissions and keycloak_openid_client_permissions on older versions")
+	}
+
+	v2Enabled, err := adminPermissionsV2Enabled(ctx, keycloakClient, realmId)
+	if err != nil {
+		return "", err
+	}
+
+	if !v2Enabled {
+		return "", fmt.Errorf("fine-grained admin permissions v2 are not enabled on realm %s, set admin_permissions_enabled on the realm first", realmId)
+	}
+
+	adminPermissionsClient, err := keycloakClient.GetAdminPermissionsClient(ctx, realmId)
+	if err != nil {
+		return "", err
+	}
+
+	return adminPermissionsClient.Id, nil
+}
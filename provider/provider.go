@@ -28,103 +28,151 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_saml_client":                        dataSourceKeycloakSamlClient(),
 			"keycloak_authentication_execution":           dataSourceKeycloakAuthenticationExecution(),
 			"keycloak_authentication_flow":                dataSourceKeycloakAuthenticationFlow(),
+			"keycloak_admin_permissions":                  dataSourceKeycloakAdminPermissions(),
+			"keycloak_authenticators":                     dataSourceKeycloakAuthenticators(),
 			"keycloak_client_description_converter":       dataSourceKeycloakClientDescriptionConverter(),
 			"keycloak_organization":                       dataSourceKeycloakOrgnization(),
+			"keycloak_organization_members":               dataSourceKeycloakOrganizationMembers(),
+			"keycloak_identity_provider_import_config":    dataSourceKeycloakIdentityProviderImportConfig(),
+			"keycloak_component":                          dataSourceKeycloakComponent(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"keycloak_realm":                                             resourceKeycloakRealm(),
-			"keycloak_realm_events":                                      resourceKeycloakRealmEvents(),
-			"keycloak_realm_default_client_scopes":                       resourceKeycloakRealmDefaultClientScopes(),
-			"keycloak_realm_optional_client_scopes":                      resourceKeycloakRealmOptionalClientScopes(),
-			"keycloak_realm_client_policy_profile":                       resourceKeycloakRealmClientPolicyProfile(),
-			"keycloak_realm_client_policy_profile_policy":                resourceKeycloakRealmClientPolicyProfilePolicy(),
-			"keycloak_realm_keystore_aes_generated":                      resourceKeycloakRealmKeystoreAesGenerated(),
-			"keycloak_realm_keystore_ecdsa_generated":                    resourceKeycloakRealmKeystoreEcdsaGenerated(),
-			"keycloak_realm_keystore_hmac_generated":                     resourceKeycloakRealmKeystoreHmacGenerated(),
-			"keycloak_realm_keystore_java_keystore":                      resourceKeycloakRealmKeystoreJavaKeystore(),
-			"keycloak_realm_keystore_rsa":                                resourceKeycloakRealmKeystoreRsa(),
-			"keycloak_realm_keystore_rsa_generated":                      resourceKeycloakRealmKeystoreRsaGenerated(),
-			"keycloak_realm_keystore_rsa_enc_generated":                  resourceKeycloakRealmKeystoreRsaEncGenerated(),
-			"keycloak_realm_user_profile":                                resourceKeycloakRealmUserProfile(),
-			"keycloak_realm_localization":                                resourceKeycloakRealmLocalization(),
-			"keycloak_required_action":                                   resourceKeycloakRequiredAction(),
-			"keycloak_group":                                             resourceKeycloakGroup(),
-			"keycloak_group_memberships":                                 resourceKeycloakGroupMemberships(),
-			"keycloak_default_groups":                                    resourceKeycloakDefaultGroups(),
-			"keycloak_default_roles":                                     resourceKeycloakDefaultRoles(),
-			"keycloak_group_roles":                                       resourceKeycloakGroupRoles(),
-			"keycloak_user":                                              resourceKeycloakUser(),
-			"keycloak_user_roles":                                        resourceKeycloakUserRoles(),
-			"keycloak_openid_client":                                     resourceKeycloakOpenidClient(),
-			"keycloak_openid_client_scope":                               resourceKeycloakOpenidClientScope(),
-			"keycloak_ldap_user_federation":                              resourceKeycloakLdapUserFederation(),
-			"keycloak_ldap_user_attribute_mapper":                        resourceKeycloakLdapUserAttributeMapper(),
-			"keycloak_hardcoded_attribute_mapper":                        resourceKeycloakHardcodedAttributeMapper(),
-			"keycloak_ldap_group_mapper":                                 resourceKeycloakLdapGroupMapper(),
-			"keycloak_ldap_role_mapper":                                  resourceKeycloakLdapRoleMapper(),
-			"keycloak_ldap_hardcoded_role_mapper":                        resourceKeycloakLdapHardcodedRoleMapper(),
-			"keycloak_ldap_hardcoded_attribute_mapper":                   resourceKeycloakLdapHardcodedAttributeMapper(),
-			"keycloak_ldap_hardcoded_group_mapper":                       resourceKeycloakLdapHardcodedGroupMapper(),
-			"keycloak_ldap_msad_user_account_control_mapper":             resourceKeycloakLdapMsadUserAccountControlMapper(),
-			"keycloak_ldap_msad_lds_user_account_control_mapper":         resourceKeycloakLdapMsadLdsUserAccountControlMapper(),
-			"keycloak_ldap_full_name_mapper":                             resourceKeycloakLdapFullNameMapper(),
-			"keycloak_ldap_custom_mapper":                                resourceKeycloakLdapCustomMapper(),
-			"keycloak_custom_user_federation":                            resourceKeycloakCustomUserFederation(),
-			"keycloak_openid_user_attribute_protocol_mapper":             resourceKeycloakOpenIdUserAttributeProtocolMapper(),
-			"keycloak_openid_user_property_protocol_mapper":              resourceKeycloakOpenIdUserPropertyProtocolMapper(),
-			"keycloak_openid_group_membership_protocol_mapper":           resourceKeycloakOpenIdGroupMembershipProtocolMapper(),
-			"keycloak_openid_full_name_protocol_mapper":                  resourceKeycloakOpenIdFullNameProtocolMapper(),
-			"keycloak_openid_hardcoded_claim_protocol_mapper":            resourceKeycloakOpenIdHardcodedClaimProtocolMapper(),
-			"keycloak_openid_audience_protocol_mapper":                   resourceKeycloakOpenIdAudienceProtocolMapper(),
-			"keycloak_openid_audience_resolve_protocol_mapper":           resourceKeycloakOpenIdAudienceResolveProtocolMapper(),
-			"keycloak_openid_hardcoded_role_protocol_mapper":             resourceKeycloakOpenIdHardcodedRoleProtocolMapper(),
-			"keycloak_openid_user_realm_role_protocol_mapper":            resourceKeycloakOpenIdUserRealmRoleProtocolMapper(),
-			"keycloak_openid_user_client_role_protocol_mapper":           resourceKeycloakOpenIdUserClientRoleProtocolMapper(),
-			"keycloak_openid_user_session_note_protocol_mapper":          resourceKeycloakOpenIdUserSessionNoteProtocolMapper(),
-			"keycloak_openid_script_protocol_mapper":                     resourceKeycloakOpenIdScriptProtocolMapper(),
-			"keycloak_openid_client_default_scopes":                      resourceKeycloakOpenidClientDefaultScopes(),
-			"keycloak_openid_client_optional_scopes":                     resourceKeycloakOpenidClientOptionalScopes(),
-			"keycloak_organization":                                      resourceKeycloakOrganization(),
-			"keycloak_saml_client":                                       resourceKeycloakSamlClient(),
-			"keycloak_saml_client_scope":                                 resourceKeycloakSamlClientScope(),
-			"keycloak_saml_client_default_scopes":                        resourceKeycloakSamlClientDefaultScopes(),
-			"keycloak_generic_client_protocol_mapper":                    resourceKeycloakGenericClientProtocolMapper(),
-			"keycloak_generic_client_role_mapper":                        resourceKeycloakGenericClientRoleMapper(),
-			"keycloak_generic_protocol_mapper":                           resourceKeycloakGenericProtocolMapper(),
-			"keycloak_generic_role_mapper":                               resourceKeycloakGenericRoleMapper(),
-			"keycloak_saml_user_attribute_protocol_mapper":               resourceKeycloakSamlUserAttributeProtocolMapper(),
-			"keycloak_saml_user_property_protocol_mapper":                resourceKeycloakSamlUserPropertyProtocolMapper(),
-			"keycloak_saml_script_protocol_mapper":                       resourceKeycloakSamlScriptProtocolMapper(),
-			"keycloak_hardcoded_attribute_identity_provider_mapper":      resourceKeycloakHardcodedAttributeIdentityProviderMapper(),
-			"keycloak_hardcoded_group_identity_provider_mapper":          resourceKeycloakHardcodedGroupIdentityProviderMapper(),
-			"keycloak_hardcoded_role_identity_provider_mapper":           resourceKeycloakHardcodedRoleIdentityProviderMapper(),
-			"keycloak_attribute_importer_identity_provider_mapper":       resourceKeycloakAttributeImporterIdentityProviderMapper(),
-			"keycloak_attribute_to_role_identity_provider_mapper":        resourceKeycloakAttributeToRoleIdentityProviderMapper(),
-			"keycloak_user_template_importer_identity_provider_mapper":   resourceKeycloakUserTemplateImporterIdentityProviderMapper(),
-			"keycloak_custom_identity_provider_mapper":                   resourceKeycloakCustomIdentityProviderMapper(),
-			"keycloak_saml_identity_provider":                            resourceKeycloakSamlIdentityProvider(),
-			"keycloak_oidc_google_identity_provider":                     resourceKeycloakOidcGoogleIdentityProvider(),
-			"keycloak_oidc_identity_provider":                            resourceKeycloakOidcIdentityProvider(),
-			"keycloak_openid_client_authorization_resource":              resourceKeycloakOpenidClientAuthorizationResource(),
-			"keycloak_openid_client_group_policy":                        resourceKeycloakOpenidClientAuthorizationGroupPolicy(),
-			"keycloak_openid_client_role_policy":                         resourceKeycloakOpenidClientAuthorizationRolePolicy(),
-			"keycloak_openid_client_aggregate_policy":                    resourceKeycloakOpenidClientAuthorizationAggregatePolicy(),
-			"keycloak_openid_client_js_policy":                           resourceKeycloakOpenidClientAuthorizationJSPolicy(),
-			"keycloak_openid_client_time_policy":                         resourceKeycloakOpenidClientAuthorizationTimePolicy(),
-			"keycloak_openid_client_user_policy":                         resourceKeycloakOpenidClientAuthorizationUserPolicy(),
-			"keycloak_openid_client_client_policy":                       resourceKeycloakOpenidClientAuthorizationClientPolicy(),
-			"keycloak_openid_client_authorization_client_scope_policy":   resourceKeycloakOpenidClientAuthorizationClientScopePolicy(),
-			"keycloak_openid_client_authorization_scope":                 resourceKeycloakOpenidClientAuthorizationScope(),
-			"keycloak_openid_client_authorization_permission":            resourceKeycloakOpenidClientAuthorizationPermission(),
-			"keycloak_openid_client_service_account_role":                resourceKeycloakOpenidClientServiceAccountRole(),
-			"keycloak_openid_client_service_account_realm_role":          resourceKeycloakOpenidClientServiceAccountRealmRole(),
+			"keycloak_realm":                                           resourceKeycloakRealm(),
+			"keycloak_realm_events":                                    resourceKeycloakRealmEvents(),
+			"keycloak_realm_default_client_scopes":                     resourceKeycloakRealmDefaultClientScopes(),
+			"keycloak_realm_optional_client_scopes":                    resourceKeycloakRealmOptionalClientScopes(),
+			"keycloak_realm_client_policy_profile":                     resourceKeycloakRealmClientPolicyProfile(),
+			"keycloak_realm_client_policy_profile_policy":              resourceKeycloakRealmClientPolicyProfilePolicy(),
+			"keycloak_realm_keystore_aes_generated":                    resourceKeycloakRealmKeystoreAesGenerated(),
+			"keycloak_realm_keystore_ecdsa_generated":                  resourceKeycloakRealmKeystoreEcdsaGenerated(),
+			"keycloak_realm_keystore_hmac_generated":                   resourceKeycloakRealmKeystoreHmacGenerated(),
+			"keycloak_realm_keystore_java_keystore":                    resourceKeycloakRealmKeystoreJavaKeystore(),
+			"keycloak_realm_keystore_rsa":                              resourceKeycloakRealmKeystoreRsa(),
+			"keycloak_realm_keystore_rsa_generated":                    resourceKeycloakRealmKeystoreRsaGenerated(),
+			"keycloak_realm_keystore_rsa_enc_generated":                resourceKeycloakRealmKeystoreRsaEncGenerated(),
+			"keycloak_realm_user_profile":                              resourceKeycloakRealmUserProfile(),
+			"keycloak_realm_localization":                              resourceKeycloakRealmLocalization(),
+			"keycloak_required_action":                                 resourceKeycloakRequiredAction(),
+			"keycloak_group":                                           resourceKeycloakGroup(),
+			"keycloak_group_memberships":                               resourceKeycloakGroupMemberships(),
+			"keycloak_default_groups":                                  resourceKeycloakDefaultGroups(),
+			"keycloak_default_roles":                                   resourceKeycloakDefaultRoles(),
+			"keycloak_group_roles":                                     resourceKeycloakGroupRoles(),
+			"keycloak_user":                                            resourceKeycloakUser(),
+			"keycloak_user_roles":                                      resourceKeycloakUserRoles(),
+			"keycloak_openid_client":                                   resourceKeycloakOpenidClient(),
+			"keycloak_openid_client_scope":                             resourceKeycloakOpenidClientScope(),
+			"keycloak_ldap_user_federation":                            resourceKeycloakLdapUserFederation(),
+			"keycloak_ldap_user_attribute_mapper":                      resourceKeycloakLdapUserAttributeMapper(),
+			"keycloak_ldap_certificate_mapper":                         resourceKeycloakLdapCertificateMapper(),
+			"keycloak_ldap_kerberos_principal_attribute_mapper":        resourceKeycloakLdapKerberosPrincipalAttributeMapper(),
+			"keycloak_hardcoded_attribute_mapper":                      resourceKeycloakHardcodedAttributeMapper(),
+			"keycloak_ldap_group_mapper":                               resourceKeycloakLdapGroupMapper(),
+			"keycloak_ldap_role_mapper":                                resourceKeycloakLdapRoleMapper(),
+			"keycloak_ldap_hardcoded_role_mapper":                      resourceKeycloakLdapHardcodedRoleMapper(),
+			"keycloak_ldap_hardcoded_attribute_mapper":                 resourceKeycloakLdapHardcodedAttributeMapper(),
+			"keycloak_ldap_hardcoded_group_mapper":                     resourceKeycloakLdapHardcodedGroupMapper(),
+			"keycloak_ldap_msad_user_account_control_mapper":           resourceKeycloakLdapMsadUserAccountControlMapper(),
+			"keycloak_ldap_msad_lds_user_account_control_mapper":       resourceKeycloakLdapMsadLdsUserAccountControlMapper(),
+			"keycloak_ldap_full_name_mapper":                           resourceKeycloakLdapFullNameMapper(),
+			"keycloak_ldap_custom_mapper":                              resourceKeycloakLdapCustomMapper(),
+			"keycloak_custom_user_federation":                          resourceKeycloakCustomUserFederation(),
+			"keycloak_kerberos_user_federation":                        resourceKeycloakKerberosUserFederation(),
+			"keycloak_component":                                       resourceKeycloakComponent(),
+			"keycloak_client_registration_policy":                      resourceKeycloakClientRegistrationPolicy(),
+			"keycloak_client_initial_access_token":                     resourceKeycloakClientInitialAccessToken(),
+			"keycloak_openid_client_certificate":                       resourceKeycloakOpenidClientCertificate(),
+			"keycloak_ldap_user_federation_sync":                       resourceKeycloakLdapUserFederationSync(),
+			"keycloak_ldap_mapper_sync":                                resourceKeycloakLdapMapperSync(),
+			"keycloak_openid_user_attribute_protocol_mapper":           resourceKeycloakOpenIdUserAttributeProtocolMapper(),
+			"keycloak_openid_user_property_protocol_mapper":            resourceKeycloakOpenIdUserPropertyProtocolMapper(),
+			"keycloak_openid_group_membership_protocol_mapper":         resourceKeycloakOpenIdGroupMembershipProtocolMapper(),
+			"keycloak_openid_full_name_protocol_mapper":                resourceKeycloakOpenIdFullNameProtocolMapper(),
+			"keycloak_openid_hardcoded_claim_protocol_mapper":          resourceKeycloakOpenIdHardcodedClaimProtocolMapper(),
+			"keycloak_openid_audience_protocol_mapper":                 resourceKeycloakOpenIdAudienceProtocolMapper(),
+			"keycloak_openid_audience_resolve_protocol_mapper":         resourceKeycloakOpenIdAudienceResolveProtocolMapper(),
+			"keycloak_openid_hardcoded_role_protocol_mapper":           resourceKeycloakOpenIdHardcodedRoleProtocolMapper(),
+			"keycloak_openid_user_realm_role_protocol_mapper":          resourceKeycloakOpenIdUserRealmRoleProtocolMapper(),
+			"keycloak_openid_user_client_role_protocol_mapper":         resourceKeycloakOpenIdUserClientRoleProtocolMapper(),
+			"keycloak_openid_user_session_note_protocol_mapper":        resourceKeycloakOpenIdUserSessionNoteProtocolMapper(),
+			"keycloak_openid_script_protocol_mapper":                   resourceKeycloakOpenIdScriptProtocolMapper(),
+			"keycloak_openid_sha256_pairwise_sub_protocol_mapper":      resourceKeycloakOpenIdSha256PairwiseSubProtocolMapper(),
+			"keycloak_openid_acr_protocol_mapper":                      resourceKeycloakOpenIdAcrProtocolMapper(),
+			"keycloak_openid_claims_parameter_protocol_mapper":         resourceKeycloakOpenIdClaimsParameterProtocolMapper(),
+			"keycloak_openid_allowed_origins_protocol_mapper":          resourceKeycloakOpenIdAllowedOriginsProtocolMapper(),
+			"keycloak_openid_address_protocol_mapper":                  resourceKeycloakOpenIdAddressProtocolMapper(),
+			"keycloak_openid_role_name_protocol_mapper":                resourceKeycloakOpenIdRoleNameProtocolMapper(),
+			"keycloak_openid_organization_membership_protocol_mapper":  resourceKeycloakOpenIdOrganizationMembershipProtocolMapper(),
+			"keycloak_openid_client_default_scopes":                    resourceKeycloakOpenidClientDefaultScopes(),
+			"keycloak_openid_client_optional_scopes":                   resourceKeycloakOpenidClientOptionalScopes(),
+			"keycloak_organization":                                    resourceKeycloakOrganization(),
+			"keycloak_organization_members":                            resourceKeycloakOrganizationMembers(),
+			"keycloak_saml_client":                                     resourceKeycloakSamlClient(),
+			"keycloak_saml_client_scope":                               resourceKeycloakSamlClientScope(),
+			"keycloak_saml_client_default_scopes":                      resourceKeycloakSamlClientDefaultScopes(),
+			"keycloak_generic_client_protocol_mapper":                  resourceKeycloakGenericClientProtocolMapper(),
+			"keycloak_generic_client_role_mapper":                      resourceKeycloakGenericClientRoleMapper(),
+			"keycloak_generic_protocol_mapper":                         resourceKeycloakGenericProtocolMapper(),
+			"keycloak_generic_role_mapper":                             resourceKeycloakGenericRoleMapper(),
+			"keycloak_saml_user_attribute_protocol_mapper":             resourceKeycloakSamlUserAttributeProtocolMapper(),
+			"keycloak_saml_user_property_protocol_mapper":              resourceKeycloakSamlUserPropertyProtocolMapper(),
+			"keycloak_saml_script_protocol_mapper":                     resourceKeycloakSamlScriptProtocolMapper(),
+			"keycloak_saml_role_list_protocol_mapper":                  resourceKeycloakSamlRoleListProtocolMapper(),
+			"keycloak_saml_group_membership_protocol_mapper":           resourceKeycloakSamlGroupMembershipProtocolMapper(),
+			"keycloak_saml_hardcoded_attribute_protocol_mapper":        resourceKeycloakSamlHardcodedAttributeProtocolMapper(),
+			"keycloak_saml_hardcoded_role_protocol_mapper":             resourceKeycloakSamlHardcodedRoleProtocolMapper(),
+			"keycloak_saml_role_name_protocol_mapper":                  resourceKeycloakSamlRoleNameProtocolMapper(),
+			"keycloak_saml_audience_protocol_mapper":                   resourceKeycloakSamlAudienceProtocolMapper(),
+			"keycloak_hardcoded_attribute_identity_provider_mapper":    resourceKeycloakHardcodedAttributeIdentityProviderMapper(),
+			"keycloak_hardcoded_group_identity_provider_mapper":        resourceKeycloakHardcodedGroupIdentityProviderMapper(),
+			"keycloak_hardcoded_role_identity_provider_mapper":         resourceKeycloakHardcodedRoleIdentityProviderMapper(),
+			"keycloak_attribute_importer_identity_provider_mapper":     resourceKeycloakAttributeImporterIdentityProviderMapper(),
+			"keycloak_attribute_to_role_identity_provider_mapper":      resourceKeycloakAttributeToRoleIdentityProviderMapper(),
+			"keycloak_user_template_importer_identity_provider_mapper": resourceKeycloakUserTemplateImporterIdentityProviderMapper(),
+			"keycloak_custom_identity_provider_mapper":                 resourceKeycloakCustomIdentityProviderMapper(),
+			"keycloak_oidc_advanced_role_identity_provider_mapper":     resourceKeycloakOidcAdvancedRoleIdentityProviderMapper(),
+			"keycloak_oidc_advanced_group_identity_provider_mapper":    resourceKeycloakOidcAdvancedGroupIdentityProviderMapper(),
+			"keycloak_saml_advanced_role_identity_provider_mapper":     resourceKeycloakSamlAdvancedRoleIdentityProviderMapper(),
+			"keycloak_saml_xpath_attribute_identity_provider_mapper":   resourceKeycloakSamlXpathAttributeIdentityProviderMapper(),
+			"keycloak_oidc_username_identity_provider_mapper":          resourceKeycloakOidcUsernameIdentityProviderMapper(),
+			"keycloak_saml_identity_provider":                          resourceKeycloakSamlIdentityProvider(),
+			"keycloak_oidc_google_identity_provider":                   resourceKeycloakOidcGoogleIdentityProvider(),
+			"keycloak_github_identity_provider":                        resourceKeycloakGithubIdentityProvider(),
+			"keycloak_gitlab_identity_provider":                        resourceKeycloakGitlabIdentityProvider(),
+			"keycloak_microsoft_identity_provider":                     resourceKeycloakMicrosoftIdentityProvider(),
+			"keycloak_facebook_identity_provider":                      resourceKeycloakFacebookIdentityProvider(),
+			"keycloak_linkedin_identity_provider":                      resourceKeycloakLinkedinIdentityProvider(),
+			"keycloak_bitbucket_identity_provider":                     resourceKeycloakBitbucketIdentityProvider(),
+			"keycloak_paypal_identity_provider":                        resourceKeycloakPaypalIdentityProvider(),
+			"keycloak_stackoverflow_identity_provider":                 resourceKeycloakStackoverflowIdentityProvider(),
+			"keycloak_instagram_identity_provider":                     resourceKeycloakInstagramIdentityProvider(),
+			"keycloak_twitter_identity_provider":                       resourceKeycloakTwitterIdentityProvider(),
+			"keycloak_oidc_identity_provider":                          resourceKeycloakOidcIdentityProvider(),
+			"keycloak_kubernetes_identity_provider":                    resourceKeycloakKubernetesIdentityProvider(),
+			"keycloak_jwt_bearer_identity_provider":                    resourceKeycloakJwtBearerIdentityProvider(),
+			"keycloak_openid_client_authorization_resource":            resourceKeycloakOpenidClientAuthorizationResource(),
+			"keycloak_openid_client_group_policy":                      resourceKeycloakOpenidClientAuthorizationGroupPolicy(),
+			"keycloak_openid_client_role_policy":                       resourceKeycloakOpenidClientAuthorizationRolePolicy(),
+			"keycloak_openid_client_aggregate_policy":                  resourceKeycloakOpenidClientAuthorizationAggregatePolicy(),
+			"keycloak_openid_client_js_policy":                         resourceKeycloakOpenidClientAuthorizationJSPolicy(),
+			"keycloak_openid_client_time_policy":                       resourceKeycloakOpenidClientAuthorizationTimePolicy(),
+			"keycloak_openid_client_regex_policy":                      resourceKeycloakOpenidClientAuthorizationRegexPolicy(),
+			"keycloak_openid_client_user_policy":                       resourceKeycloakOpenidClientAuthorizationUserPolicy(),
+			"keycloak_openid_client_client_policy":                     resourceKeycloakOpenidClientAuthorizationClientPolicy(),
+			"keycloak_openid_client_authorization_client_scope_policy": resourceKeycloakOpenidClientAuthorizationClientScopePolicy(),
+			"keycloak_openid_client_authorization_scope":               resourceKeycloakOpenidClientAuthorizationScope(),
+			"keycloak_openid_client_authorization_permission":          resourceKeycloakOpenidClientAuthorizationPermission(),
+			"keycloak_openid_client_service_account_role":              resourceKeycloakOpenidClientServiceAccountRole(),
+			"keycloak_openid_client_service_account_realm_role":        resourceKeycloakOpenidClientServiceAccountRealmRole(),
 			"keycloak_role":                                              resourceKeycloakRole(),
 			"keycloak_authentication_flow":                               resourceKeycloakAuthenticationFlow(),
+			"keycloak_authentication_flow_tree":                          resourceKeycloakAuthenticationFlowTree(),
 			"keycloak_authentication_subflow":                            resourceKeycloakAuthenticationSubFlow(),
 			"keycloak_authentication_execution":                          resourceKeycloakAuthenticationExecution(),
 			"keycloak_authentication_execution_config":                   resourceKeycloakAuthenticationExecutionConfig(),
 			"keycloak_identity_provider_token_exchange_scope_permission": resourceKeycloakIdentityProviderTokenExchangeScopePermission(),
 			"keycloak_openid_client_permissions":                         resourceKeycloakOpenidClientPermissions(),
+			"keycloak_admin_permission":                                  resourceKeycloakAdminPermission(),
 			"keycloak_users_permissions":                                 resourceKeycloakUsersPermissions(),
 			"keycloak_user_groups":                                       resourceKeycloakUserGroups(),
 			"keycloak_group_permissions":                                 resourceKeycloakGroupPermissions(),
@@ -229,10 +277,27 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 					Type: schema.TypeString,
 				},
 			},
+			"deletion_protection": {
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Description: "Default value of the `deletion_protection` attribute of the resources supporting it, when the attribute is not set on the resource.",
+				DefaultFunc: schema.EnvDefaultFunc("KEYCLOAK_DELETION_PROTECTION", false),
+			},
 		},
 	}
 
+	for resourceType, identity := range resourceIdentities {
+		addResourceIdentity(provider.ResourcesMap[resourceType], identity)
+	}
+
+	defaults := &providerDefaults{}
+	for _, resourceType := range deletionProtectedResourceTypes {
+		addDeletionProtection(resourceType, provider.ResourcesMap[resourceType], defaults)
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		defaults.deletionProtection = data.Get("deletion_protection").(bool)
+
 		if client != nil {
 			return client, nil
 		}
@@ -270,7 +335,6 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 				Detail:   err.Error(),
 			})
 		}
-
 		return keycloakClient, diags
 	}
 
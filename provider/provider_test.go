@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
@@ -147,6 +150,22 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProvider_resourceIdentities(t *testing.T) {
+	t.Parallel()
+
+	for resourceType, resource := range testAccProvider.ResourcesMap {
+		if resource.Identity == nil && !slices.Contains(resourcesWithoutIdentity, resourceType) {
+			t.Errorf("resource %s has no identity, add one to resourceIdentities or list it in resourcesWithoutIdentity", resourceType)
+		}
+	}
+
+	for _, resourceType := range resourcesWithoutIdentity {
+		if _, ok := testAccProvider.ResourcesMap[resourceType]; !ok {
+			t.Errorf("resource %s listed in resourcesWithoutIdentity does not exist", resourceType)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	for _, requiredEnvironmentVariable := range requiredEnvironmentVariables {
 		if value := os.Getenv(requiredEnvironmentVariable); value == "" {
@@ -154,3 +173,24 @@ func testAccPreCheck(t *testing.T) {
 		}
 	}
 }
+
+// testAccCheckResourceImportableFromIdentity imports a resource from an identity, and checks that it resolves to the resource in the state
+func testAccCheckResourceImportableFromIdentity(resourceName string, identity map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		states, err := testAccProvider.ImportStateWithIdentity(testCtx, &terraform.InstanceInfo{Type: rs.Type}, "", identity)
+		if err != nil {
+			return err
+		}
+
+		if len(states) != 1 || states[0].ID != rs.Primary.ID {
+			return fmt.Errorf("expected identity %v to be imported as %s", identity, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
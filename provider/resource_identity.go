@@ -0,0 +1,1346 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+const (
+	userStorageProviderType       = "org.keycloak.storage.UserStorageProvider"
+	ldapStorageMapperProviderType = "org.keycloak.storage.ldap.mappers.LDAPStorageMapper"
+	keyProviderType               = "org.keycloak.keys.KeyProvider"
+)
+
+// resourceIdentity describes the identity of a resource using natural keys (realm name, client_id, group path...)
+// instead of the internal ids used by the import IDs. Resources can be imported using their identity, either from an
+// identity block or from an import ID made of the natural keys.
+type resourceIdentity struct {
+	// attributes that must be set in an identity block to import the resource
+	required []string
+	// attributes that are only needed for some resources of this type, such as the parent client or client scope of a protocol mapper
+	optional []string
+	// set when one of the natural keys can be updated in place, such as the name of a group or the client_id of its parent client
+	mutable bool
+	// importIdFormats lists the formats of the import IDs made of natural keys, whose placeholders are named after
+	// the attributes of the identity. Defaults to the required attributes, separated by slashes.
+	importIdFormats []importIdFormat
+	// importId resolves an identity into the import ID understood by the resource importer
+	importId func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error)
+	// values computes the identity of a resource from its state
+	values func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error)
+}
+
+func (r *resourceIdentity) identitySchema() map[string]*schema.Schema {
+	identitySchema := map[string]*schema.Schema{}
+
+	for _, attribute := range r.required {
+		identitySchema[attribute] = &schema.Schema{
+			Type:              schema.TypeString,
+			RequiredForImport: true,
+		}
+	}
+	for _, attribute := range r.optional {
+		identitySchema[attribute] = &schema.Schema{
+			Type:              schema.TypeString,
+			OptionalForImport: true,
+		}
+	}
+
+	return identitySchema
+}
+
+// withImportIdFormats returns a copy of the identity accepting the given import ID formats
+func (r resourceIdentity) withImportIdFormats(formats ...importIdFormat) *resourceIdentity {
+	r.importIdFormats = formats
+
+	return &r
+}
+
+// addResourceIdentity declares the identity schema of a resource, and wraps its importer so it can be imported
+// from an identity block or from an import ID made of natural keys, as well as its create, read and update functions
+// so the identity is kept up-to-date.
+func addResourceIdentity(resource *schema.Resource, identity *resourceIdentity) {
+	resource.Identity = &schema.ResourceIdentity{
+		Version:    0,
+		SchemaFunc: identity.identitySchema,
+	}
+	resource.ResourceBehavior.MutableIdentity = identity.mutable
+
+	importer := resource.Importer.StateContext
+	resource.Importer.StateContext = func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		keycloakClient := meta.(*keycloak.KeycloakClient)
+
+		// an empty id means that the resource is imported from an identity block
+		if data.Id() == "" {
+			identityData, err := data.Identity()
+			if err != nil {
+				return nil, err
+			}
+
+			id, err := identity.importId(ctx, keycloakClient, identity.fromIdentityData(identityData))
+			if err != nil {
+				return nil, err
+			}
+
+			data.SetId(id)
+
+			return importer(ctx, data, meta)
+		}
+
+		id, notFoundErr, err := identity.parseImportId(ctx, keycloakClient, data.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		data.SetId(id)
+
+		resourceData, err := importer(ctx, data, meta)
+		if notFoundErr != nil && keycloak.ErrorIs404(err) {
+			// the import ID was neither made of natural keys nor of internal ids
+			return nil, identity.invalidImportIdError(notFoundErr)
+		}
+
+		return resourceData, err
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = withResourceIdentity(resource.CreateContext, identity, false)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = withResourceIdentity(resource.ReadContext, identity, identity.mutable)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = withResourceIdentity(resource.UpdateContext, identity, identity.mutable)
+	}
+}
+
+// withResourceIdentity sets the identity of the resource after the operation. Computing the identity can take lookups
+// through the Keycloak API, so an identity which is already set is only computed again when refresh is set.
+func withResourceIdentity(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, identity *resourceIdentity, refresh bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diagnostics := operation(ctx, data, meta)
+		// the resource could have been removed from the state if it no longer exists
+		if diagnostics.HasError() || data.Id() == "" {
+			return diagnostics
+		}
+
+		identityData, err := data.Identity()
+		if err != nil {
+			return append(diagnostics, diag.FromErr(err)...)
+		}
+
+		if !refresh && identity.isSet(identityData) {
+			return diagnostics
+		}
+
+		// the identity is only needed to import the resource again, so failing to compute it doesn't fail the operation
+		values, err := identity.values(ctx, meta.(*keycloak.KeycloakClient), data)
+		if err != nil {
+			return append(diagnostics, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to compute the resource identity",
+				Detail:   err.Error(),
+			})
+		}
+
+		for key, value := range values {
+			if value == "" {
+				continue
+			}
+
+			if err := identityData.Set(key, value); err != nil {
+				return append(diagnostics, diag.FromErr(err)...)
+			}
+		}
+
+		return diagnostics
+	}
+}
+
+// isSet returns whether all the required attributes of the identity are set
+func (r *resourceIdentity) isSet(identity *schema.IdentityData) bool {
+	for _, attribute := range r.required {
+		if identityString(identity, attribute) == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *resourceIdentity) fromIdentityData(identityData *schema.IdentityData) map[string]string {
+	identity := map[string]string{}
+	for _, attribute := range append(append([]string{}, r.required...), r.optional...) {
+		identity[attribute] = identityString(identityData, attribute)
+	}
+
+	return identity
+}
+
+func identityString(identity *schema.IdentityData, key string) string {
+	if value, ok := identity.GetOk(key); ok {
+		return value.(string)
+	}
+
+	return ""
+}
+
+// realm scoped resources, such as keycloak_realm_events, which are identified by their realm only
+
+func realmScopedResourceIdentity(realmAttribute string) *resourceIdentity {
+	return &resourceIdentity{
+		required: []string{realmAttribute},
+		importId: func(_ context.Context, _ *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+			return identity[realmAttribute], nil
+		},
+		values: func(_ context.Context, _ *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+			return map[string]string{
+				realmAttribute: data.Get(realmAttribute).(string),
+			}, nil
+		},
+	}
+}
+
+// resources whose import ID is already made of natural keys, such as {{realm}}/{{alias}} for identity providers
+
+func naturalKeyResourceIdentity(realmAttribute, keyAttribute string) *resourceIdentity {
+	return &resourceIdentity{
+		required: []string{realmAttribute, keyAttribute},
+		importId: func(_ context.Context, _ *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+			return fmt.Sprintf("%s/%s", identity[realmAttribute], identity[keyAttribute]), nil
+		},
+		values: func(_ context.Context, _ *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+			return map[string]string{
+				realmAttribute: data.Get(realmAttribute).(string),
+				keyAttribute:   data.Get(keyAttribute).(string),
+			}, nil
+		},
+	}
+}
+
+// resources identified by a name which is unique within a realm, and imported with {{realm}}/{{id}}
+
+func namedResourceIdentity(realmAttribute, nameAttribute string, mutable bool, idFromName func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, name string) (string, error)) *resourceIdentity {
+	return &resourceIdentity{
+		required: []string{realmAttribute, nameAttribute},
+		mutable:  mutable,
+		importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+			realmId := identity[realmAttribute]
+
+			id, err := idFromName(ctx, keycloakClient, realmId, identity[nameAttribute])
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("%s/%s", realmId, id), nil
+		},
+		values: func(_ context.Context, _ *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+			return map[string]string{
+				realmAttribute: data.Get(realmAttribute).(string),
+				nameAttribute:  data.Get(nameAttribute).(string),
+			}, nil
+		},
+	}
+}
+
+func clientIdFromClientId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId string) (string, error) {
+	client, err := keycloakClient.GetGenericClientByClientId(ctx, realmId, clientId)
+	if err != nil {
+		return "", err
+	}
+
+	return client.Id, nil
+}
+
+func clientIdFromId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, id string) (string, error) {
+	client, err := keycloakClient.GetGenericClient(ctx, realmId, id)
+	if err != nil {
+		return "", err
+	}
+
+	return client.ClientId, nil
+}
+
+func clientScopeIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, name string) (string, error) {
+	return keycloakClient.GetClientScopeIdByName(ctx, realmId, name)
+}
+
+func clientScopeNameFromId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, id string) (string, error) {
+	clientScope, err := keycloakClient.GetOpenidClientScope(ctx, realmId, id)
+	if err != nil {
+		return "", err
+	}
+
+	return clientScope.Name, nil
+}
+
+func groupIdFromPath(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, path string) (string, error) {
+	group, err := keycloakClient.GetGroupByPath(ctx, realmId, path)
+	if err != nil {
+		return "", err
+	}
+
+	return group.Id, nil
+}
+
+func groupPathFromId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, id string) (string, error) {
+	group, err := keycloakClient.GetGroup(ctx, realmId, id)
+	if err != nil {
+		return "", err
+	}
+
+	return group.Path, nil
+}
+
+func userIdFromUsername(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, username string) (string, error) {
+	user, err := keycloakClient.GetUserByUsername(ctx, realmId, username)
+	if err != nil {
+		return "", err
+	}
+	if user == nil {
+		return "", fmt.Errorf("no user found for username %s", username)
+	}
+
+	return user.Id, nil
+}
+
+func usernameFromId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, id string) (string, error) {
+	user, err := keycloakClient.GetUser(ctx, realmId, id)
+	if err != nil {
+		return "", err
+	}
+
+	return user.Username, nil
+}
+
+func userFederationIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, name string) (string, error) {
+	return keycloakClient.GetComponentIdByName(ctx, realmId, "", userStorageProviderType, name)
+}
+
+func keystoreIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, name string) (string, error) {
+	return keycloakClient.GetComponentIdByName(ctx, realmId, "", keyProviderType, name)
+}
+
+// the default client registration policies of a realm share their names between the anonymous and authenticated sub
+// types
+func clientRegistrationPolicyIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, subType, name string) (string, error) {
+	policies, err := keycloakClient.ListComponents(ctx, realmId, "", keycloak.ClientRegistrationPolicyProviderType)
+	if err != nil {
+		return "", err
+	}
+
+	for _, policy := range policies {
+		if policy.SubType == subType && policy.Name == name {
+			return policy.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("no %s client registration policy with name %s found in realm %s", subType, name, realmId)
+}
+
+func organizationIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, name string) (string, error) {
+	organization, err := keycloakClient.GetOrganizationByName(ctx, realmId, name)
+	if err != nil {
+		return "", err
+	}
+
+	return organization.Id, nil
+}
+
+func organizationNameFromId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, id string) (string, error) {
+	organization, err := keycloakClient.GetOrganization(ctx, realmId, id)
+	if err != nil {
+		return "", err
+	}
+
+	return organization.Name, nil
+}
+
+func authenticationFlowIdFromAlias(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, alias string) (string, error) {
+	flow, err := keycloakClient.GetAuthenticationFlowFromAlias(ctx, realmId, alias)
+	if err != nil {
+		return "", err
+	}
+
+	return flow.Id, nil
+}
+
+// roleIdFromName finds a realm role, or a client role when roleClientId (the client_id of the client owning the role) is set
+func roleIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, roleClientId, name string) (string, error) {
+	clientId := ""
+	if roleClientId != "" {
+		var err error
+		clientId, err = clientIdFromClientId(ctx, keycloakClient, realmId, roleClientId)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	role, err := keycloakClient.GetRoleByName(ctx, realmId, clientId, name)
+	if err != nil {
+		return "", err
+	}
+
+	return role.Id, nil
+}
+
+// roleNaturalKeys returns the name of a role, and the client_id of the client owning the role if this is a client role
+func roleNaturalKeys(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, roleId string) (string, string, error) {
+	role, err := keycloakClient.GetRole(ctx, realmId, roleId)
+	if err != nil {
+		return "", "", err
+	}
+	if !role.ClientRole {
+		return "", role.Name, nil
+	}
+
+	roleClientId, err := clientIdFromId(ctx, keycloakClient, realmId, role.ContainerId)
+	if err != nil {
+		return "", "", err
+	}
+
+	return roleClientId, role.Name, nil
+}
+
+func serviceAccountUserIdFromClientId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId string) (string, error) {
+	id, err := clientIdFromClientId(ctx, keycloakClient, realmId, clientId)
+	if err != nil {
+		return "", err
+	}
+
+	serviceAccountUser, err := keycloakClient.GetOpenidClientServiceAccountUserId(ctx, realmId, id)
+	if err != nil {
+		return "", err
+	}
+
+	return serviceAccountUser.Id, nil
+}
+
+func serviceAccountClientIdFromUserId(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, serviceAccountUserId string) (string, error) {
+	serviceAccountUser, err := keycloakClient.GetUser(ctx, realmId, serviceAccountUserId)
+	if err != nil {
+		return "", err
+	}
+
+	return clientIdFromId(ctx, keycloakClient, realmId, serviceAccountUser.ServiceAccountClientId)
+}
+
+// resources attached to a client, such as keycloak_openid_client_default_scopes, identified by the client_id of the client
+
+var clientAttachedResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "client_id"},
+	mutable:  true,
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		id, err := clientIdFromClientId(ctx, keycloakClient, realmId, identity["client_id"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s", realmId, id), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+
+		clientId, err := clientIdFromId(ctx, keycloakClient, realmId, data.Get("client_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id":  realmId,
+			"client_id": clientId,
+		}, nil
+	},
+}
+
+// resources attached to a group, such as keycloak_group_roles, identified by the path of the group
+
+var groupAttachedResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "group_path"},
+	mutable:  true,
+	importIdFormats: []importIdFormat{
+		{format: "{{realm_id}}//{{group_path}}", greedy: true},
+	},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		groupId, err := groupIdFromPath(ctx, keycloakClient, realmId, identity["group_path"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s", realmId, groupId), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+
+		groupPath, err := groupPathFromId(ctx, keycloakClient, realmId, data.Get("group_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id":   realmId,
+			"group_path": groupPath,
+		}, nil
+	},
+}
+
+// resources attached to an organization, such as keycloak_organization_members, identified by the name of the organization
+
+var organizationAttachedResourceIdentity = &resourceIdentity{
+	required: []string{"realm", "organization"},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realm := identity["realm"]
+
+		organizationId, err := organizationIdFromName(ctx, keycloakClient, realm, identity["organization"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s", realm, organizationId), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realm := data.Get("realm").(string)
+
+		organization, err := organizationNameFromId(ctx, keycloakClient, realm, data.Get("organization_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm":        realm,
+			"organization": organization,
+		}, nil
+	},
+}
+
+// resources attached to a user, such as keycloak_user_roles, identified by the username of the user
+
+var userAttachedResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "username"},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		userId, err := userIdFromUsername(ctx, keycloakClient, realmId, identity["username"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s", realmId, userId), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+
+		username, err := usernameFromId(ctx, keycloakClient, realmId, data.Get("user_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id": realmId,
+			"username": username,
+		}, nil
+	},
+}
+
+var groupResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "path"},
+	mutable:  true,
+	importIdFormats: []importIdFormat{
+		{format: "{{realm_id}}//{{path}}", greedy: true},
+	},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		groupId, err := groupIdFromPath(ctx, keycloakClient, realmId, identity["path"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s", realmId, groupId), nil
+	},
+	values: func(_ context.Context, _ *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		return map[string]string{
+			"realm_id": data.Get("realm_id").(string),
+			"path":     data.Get("path").(string),
+		}, nil
+	},
+}
+
+// client_id is the client_id of the client owning the role, and is only set for client roles
+var roleResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "name"},
+	optional: []string{"client_id"},
+	mutable:  true,
+	importIdFormats: []importIdFormat{
+		{format: "{{realm_id}}/{{client_id}}/{{name}}"},
+		{format: "{{realm_id}}/{{name}}"},
+	},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		roleId, err := roleIdFromName(ctx, keycloakClient, realmId, identity["client_id"], identity["name"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s", realmId, roleId), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+
+		clientId := ""
+		if id := data.Get("client_id").(string); id != "" {
+			var err error
+			clientId, err = clientIdFromId(ctx, keycloakClient, realmId, id)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return map[string]string{
+			"realm_id":  realmId,
+			"client_id": clientId,
+			"name":      data.Get("name").(string),
+		}, nil
+	},
+}
+
+var defaultRolesResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id"},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		realm, err := keycloakClient.GetRealm(ctx, realmId)
+		if err != nil {
+			return "", err
+		}
+		if realm.DefaultRole == nil {
+			return "", fmt.Errorf("realm %s does not have a default role", realmId)
+		}
+
+		return fmt.Sprintf("%s/%s", realmId, realm.DefaultRole.Id), nil
+	},
+	values: func(_ context.Context, _ *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		return map[string]string{
+			"realm_id": data.Get("realm_id").(string),
+		}, nil
+	},
+}
+
+// protocol mappers are attached to either a client or a client scope, identified by the client_id of the client or the name of the client scope
+var protocolMapperResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "name"},
+	optional: []string{"client_id", "client_scope"},
+	mutable:  true,
+	importIdFormats: []importIdFormat{
+		{format: "{{realm_id}}/{{client_id}}/{{name}}"},
+		{format: "{{realm_id}}/client-scope/{{client_scope}}/{{name}}"},
+	},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		parentResourceType, clientId, clientScopeId, err := protocolMapperParentFromIdentity(ctx, keycloakClient, realmId, identity["client_id"], identity["client_scope"])
+		if err != nil {
+			return "", err
+		}
+
+		protocolMapperId, err := keycloakClient.GetProtocolMapperIdByName(ctx, realmId, clientId, clientScopeId, identity["name"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s/%s%s/%s", realmId, parentResourceType, clientId, clientScopeId, protocolMapperId), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+
+		clientId, clientScope, err := protocolMapperParentNaturalKeys(ctx, keycloakClient, realmId, data.Get("client_id").(string), data.Get("client_scope_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id":     realmId,
+			"client_id":    clientId,
+			"client_scope": clientScope,
+			"name":         data.Get("name").(string),
+		}, nil
+	},
+}
+
+// protocolMapperParentFromIdentity resolves the parent of a protocol mapper or a scope mapping, returning its type
+// as used in import IDs (client or client-scope) and its id
+func protocolMapperParentFromIdentity(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId, clientScope string) (string, string, string, error) {
+	if clientId != "" && clientScope != "" {
+		return "", "", "", fmt.Errorf("only one of client_id or client_scope can be set in the identity")
+	}
+
+	if clientId != "" {
+		id, err := clientIdFromClientId(ctx, keycloakClient, realmId, clientId)
+		return "client", id, "", err
+	}
+
+	if clientScope != "" {
+		id, err := clientScopeIdFromName(ctx, keycloakClient, realmId, clientScope)
+		return "client-scope", "", id, err
+	}
+
+	return "", "", "", fmt.Errorf("one of client_id or client_scope must be set in the identity")
+}
+
+func protocolMapperParentNaturalKeys(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, clientId, clientScopeId string) (string, string, error) {
+	if clientId != "" {
+		id, err := clientIdFromId(ctx, keycloakClient, realmId, clientId)
+		return id, "", err
+	}
+
+	name, err := clientScopeNameFromId(ctx, keycloakClient, realmId, clientScopeId)
+	return "", name, err
+}
+
+// role mappers are identified by their parent client or client scope, and the role being mapped
+var roleMapperResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "role"},
+	optional: []string{"client_id", "client_scope", "role_client_id"},
+	mutable:  true,
+	importIdFormats: []importIdFormat{
+		{format: "{{realm_id}}/client/{{client_id}}/scope-mappings/{{role}}"},
+		{format: "{{realm_id}}/client/{{client_id}}/scope-mappings/{{role_client_id}}/{{role}}"},
+		{format: "{{realm_id}}/client-scope/{{client_scope}}/scope-mappings/{{role}}"},
+		{format: "{{realm_id}}/client-scope/{{client_scope}}/scope-mappings/{{role_client_id}}/{{role}}"},
+	},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		parentResourceType, clientId, clientScopeId, err := protocolMapperParentFromIdentity(ctx, keycloakClient, realmId, identity["client_id"], identity["client_scope"])
+		if err != nil {
+			return "", err
+		}
+
+		roleClientId := identity["role_client_id"]
+		roleId, err := roleIdFromName(ctx, keycloakClient, realmId, roleClientId, identity["role"])
+		if err != nil {
+			return "", err
+		}
+
+		roleContainerId := realmId
+		if roleClientId != "" {
+			roleContainerId, err = clientIdFromClientId(ctx, keycloakClient, realmId, roleClientId)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		return fmt.Sprintf("%s/%s/%s%s/scope-mappings/%s/%s", realmId, parentResourceType, clientId, clientScopeId, roleContainerId, roleId), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+
+		clientId, clientScope, err := protocolMapperParentNaturalKeys(ctx, keycloakClient, realmId, data.Get("client_id").(string), data.Get("client_scope_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		roleClientId, role, err := roleNaturalKeys(ctx, keycloakClient, realmId, data.Get("role_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id":       realmId,
+			"client_id":      clientId,
+			"client_scope":   clientScope,
+			"role_client_id": roleClientId,
+			"role":           role,
+		}, nil
+	},
+}
+
+// ldap mappers are identified by the name of their ldap user federation
+var ldapMapperResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "user_federation", "name"},
+	mutable:  true,
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		userFederationId, err := userFederationIdFromName(ctx, keycloakClient, realmId, identity["user_federation"])
+		if err != nil {
+			return "", err
+		}
+
+		mapperId, err := keycloakClient.GetComponentIdByName(ctx, realmId, userFederationId, ldapStorageMapperProviderType, identity["name"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s/%s", realmId, userFederationId, mapperId), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+
+		ldapUserFederation, err := keycloakClient.GetLdapUserFederation(ctx, realmId, data.Get("ldap_user_federation_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id":        realmId,
+			"user_federation": ldapUserFederation.Name,
+			"name":            data.Get("name").(string),
+		}, nil
+	},
+}
+
+var clientRegistrationPolicyResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "sub_type", "name"},
+	mutable:  true,
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		policyId, err := clientRegistrationPolicyIdFromName(ctx, keycloakClient, realmId, identity["sub_type"], identity["name"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s", realmId, policyId), nil
+	},
+	values: func(_ context.Context, _ *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		return map[string]string{
+			"realm_id": data.Get("realm_id").(string),
+			"sub_type": data.Get("sub_type").(string),
+			"name":     data.Get("name").(string),
+		}, nil
+	},
+}
+
+// components are identified by their provider type and name, the components of the realm which share both are
+// told apart by the first one found
+var componentResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "provider_type", "name"},
+	mutable:  true,
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		componentId, err := keycloakClient.GetComponentIdByName(ctx, realmId, "", identity["provider_type"], identity["name"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s", realmId, componentId), nil
+	},
+	values: func(_ context.Context, _ *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		return map[string]string{
+			"realm_id":      data.Get("realm_id").(string),
+			"provider_type": data.Get("provider_type").(string),
+			"name":          data.Get("name").(string),
+		}, nil
+	},
+}
+
+var identityProviderMapperResourceIdentity = &resourceIdentity{
+	required: []string{"realm", "identity_provider_alias", "name"},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realm := identity["realm"]
+		alias := identity["identity_provider_alias"]
+
+		mapper, err := keycloakClient.GetIdentityProviderMapperByName(ctx, realm, alias, identity["name"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s/%s", realm, alias, mapper.Id), nil
+	},
+	values: func(_ context.Context, _ *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		return map[string]string{
+			"realm":                   data.Get("realm").(string),
+			"identity_provider_alias": data.Get("identity_provider_alias").(string),
+			"name":                    data.Get("name").(string),
+		}, nil
+	},
+}
+
+// authorization resources, scopes, policies and permissions are identified by the client_id of their resource server
+func authorizationResourceIdentity(idFromName func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, resourceServerId, name string) (string, error)) *resourceIdentity {
+	return &resourceIdentity{
+		required: []string{"realm_id", "resource_server", "name"},
+		mutable:  true,
+		importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+			realmId := identity["realm_id"]
+
+			resourceServerId, err := clientIdFromClientId(ctx, keycloakClient, realmId, identity["resource_server"])
+			if err != nil {
+				return "", err
+			}
+
+			id, err := idFromName(ctx, keycloakClient, realmId, resourceServerId, identity["name"])
+			if err != nil {
+				return "", err
+			}
+
+			return fmt.Sprintf("%s/%s/%s", realmId, resourceServerId, id), nil
+		},
+		values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+			realmId := data.Get("realm_id").(string)
+
+			resourceServer, err := clientIdFromId(ctx, keycloakClient, realmId, data.Get("resource_server_id").(string))
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]string{
+				"realm_id":        realmId,
+				"resource_server": resourceServer,
+				"name":            data.Get("name").(string),
+			}, nil
+		},
+	}
+}
+
+func authorizationPolicyIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, resourceServerId, name string) (string, error) {
+	policy, err := keycloakClient.GetClientAuthorizationPolicyByName(ctx, realmId, resourceServerId, name)
+	if err != nil {
+		return "", err
+	}
+
+	return policy.Id, nil
+}
+
+func adminPermissionIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, name string) (string, error) {
+	adminPermissionsClient, err := keycloakClient.GetAdminPermissionsClient(ctx, realmId)
+	if err != nil {
+		return "", err
+	}
+
+	return authorizationPolicyIdFromName(ctx, keycloakClient, realmId, adminPermissionsClient.Id, name)
+}
+
+func authorizationResourceIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, resourceServerId, name string) (string, error) {
+	resource, err := keycloakClient.GetOpenidClientAuthorizationResourceByName(ctx, realmId, resourceServerId, name)
+	if err != nil {
+		return "", err
+	}
+
+	return resource.Id, nil
+}
+
+func authorizationScopeIdFromName(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, resourceServerId, name string) (string, error) {
+	scope, err := keycloakClient.GetOpenidClientAuthorizationScopeByName(ctx, realmId, resourceServerId, name)
+	if err != nil {
+		return "", err
+	}
+
+	return scope.Id, nil
+}
+
+var authenticationSubFlowResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "parent_flow_alias", "alias"},
+	mutable:  true,
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+		parentFlowAlias := identity["parent_flow_alias"]
+
+		id, err := keycloakClient.GetAuthenticationSubFlowIdFromAlias(ctx, realmId, parentFlowAlias, identity["alias"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s/%s", realmId, parentFlowAlias, id), nil
+	},
+	values: func(_ context.Context, _ *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		return map[string]string{
+			"realm_id":          data.Get("realm_id").(string),
+			"parent_flow_alias": data.Get("parent_flow_alias").(string),
+			"alias":             data.Get("alias").(string),
+		}, nil
+	},
+}
+
+// executions are identified by their authenticator within their parent flow, and by their index among the executions
+// of the parent flow with the same authenticator, as a flow can run the same authenticator more than once
+var authenticationExecutionResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "parent_flow_alias", "authenticator"},
+	optional: []string{"index"},
+	mutable:  true,
+	importIdFormats: []importIdFormat{
+		{format: "{{realm_id}}/{{parent_flow_alias}}/{{authenticator}}"},
+		{format: "{{realm_id}}/{{parent_flow_alias}}/{{authenticator}}/{{index}}"},
+	},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+		parentFlowAlias := identity["parent_flow_alias"]
+
+		execution, err := authenticationExecutionFromAuthenticator(ctx, keycloakClient, realmId, parentFlowAlias, identity["authenticator"], identity["index"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s/%s", realmId, parentFlowAlias, execution.Id), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+		parentFlowAlias := data.Get("parent_flow_alias").(string)
+		authenticator := data.Get("authenticator").(string)
+
+		index, err := authenticationExecutionIndex(ctx, keycloakClient, realmId, parentFlowAlias, authenticator, data.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id":          realmId,
+			"parent_flow_alias": parentFlowAlias,
+			"authenticator":     authenticator,
+			"index":             index,
+		}, nil
+	},
+}
+
+// execution configs are identified by the execution they configure
+var authenticationExecutionConfigResourceIdentity = &resourceIdentity{
+	required:        authenticationExecutionResourceIdentity.required,
+	optional:        authenticationExecutionResourceIdentity.optional,
+	mutable:         true,
+	importIdFormats: authenticationExecutionResourceIdentity.importIdFormats,
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+		authenticator := identity["authenticator"]
+
+		execution, err := authenticationExecutionFromAuthenticator(ctx, keycloakClient, realmId, identity["parent_flow_alias"], authenticator, identity["index"])
+		if err != nil {
+			return "", err
+		}
+		if execution.AuthenticationConfig == "" {
+			return "", fmt.Errorf("execution %s is not configured", authenticator)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", realmId, execution.Id, execution.AuthenticationConfig), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+		executionId := data.Get("execution_id").(string)
+
+		execution, err := keycloakClient.GetAuthenticationExecution(ctx, realmId, "", executionId)
+		if err != nil {
+			return nil, err
+		}
+
+		parentFlow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, execution.ParentFlowId)
+		if err != nil {
+			return nil, err
+		}
+
+		index, err := authenticationExecutionIndex(ctx, keycloakClient, realmId, parentFlow.Alias, execution.Authenticator, executionId)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id":          realmId,
+			"parent_flow_alias": parentFlow.Alias,
+			"authenticator":     execution.Authenticator,
+			"index":             index,
+		}, nil
+	},
+}
+
+// authenticationExecutionsWithAuthenticator returns the executions of a flow running an authenticator, in the order of the flow.
+// The executions of its subflows are left out, as they are identified by the alias of their own parent flow.
+func authenticationExecutionsWithAuthenticator(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, parentFlowAlias, authenticator string) ([]*keycloak.AuthenticationExecutionInfo, error) {
+	executions, err := keycloakClient.ListAuthenticationExecutions(ctx, realmId, parentFlowAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingExecutions []*keycloak.AuthenticationExecutionInfo
+	for _, execution := range executions {
+		if execution.Level == 0 && execution.ProviderId == authenticator {
+			matchingExecutions = append(matchingExecutions, execution)
+		}
+	}
+
+	return matchingExecutions, nil
+}
+
+func authenticationExecutionFromAuthenticator(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, parentFlowAlias, authenticator, index string) (*keycloak.AuthenticationExecutionInfo, error) {
+	position := 0
+	if index != "" {
+		var err error
+		position, err = strconv.Atoi(index)
+		if err != nil || position < 0 {
+			return nil, fmt.Errorf("invalid index %s of execution %s, it must be a positive number", index, authenticator)
+		}
+	}
+
+	executions, err := authenticationExecutionsWithAuthenticator(ctx, keycloakClient, realmId, parentFlowAlias, authenticator)
+	if err != nil {
+		return nil, err
+	}
+	if position >= len(executions) {
+		return nil, fmt.Errorf("no execution %s at index %d under parent flow alias %s found", authenticator, position, parentFlowAlias)
+	}
+
+	return executions[position], nil
+}
+
+func authenticationExecutionIndex(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, parentFlowAlias, authenticator, id string) (string, error) {
+	executions, err := authenticationExecutionsWithAuthenticator(ctx, keycloakClient, realmId, parentFlowAlias, authenticator)
+	if err != nil {
+		return "", err
+	}
+
+	for index, execution := range executions {
+		if execution.Id == id {
+			return strconv.Itoa(index), nil
+		}
+	}
+
+	return "", fmt.Errorf("execution %s not found under parent flow alias %s", id, parentFlowAlias)
+}
+
+var serviceAccountRealmRoleResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "service_account_client_id", "role"},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		serviceAccountUserId, err := serviceAccountUserIdFromClientId(ctx, keycloakClient, realmId, identity["service_account_client_id"])
+		if err != nil {
+			return "", err
+		}
+
+		roleId, err := roleIdFromName(ctx, keycloakClient, realmId, "", identity["role"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s/%s", realmId, serviceAccountUserId, roleId), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+
+		serviceAccountClientId, err := serviceAccountClientIdFromUserId(ctx, keycloakClient, realmId, data.Get("service_account_user_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id":                  realmId,
+			"service_account_client_id": serviceAccountClientId,
+			"role":                      data.Get("role").(string),
+		}, nil
+	},
+}
+
+var serviceAccountClientRoleResourceIdentity = &resourceIdentity{
+	required: []string{"realm_id", "service_account_client_id", "role_client_id", "role"},
+	importId: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, identity map[string]string) (string, error) {
+		realmId := identity["realm_id"]
+
+		serviceAccountUserId, err := serviceAccountUserIdFromClientId(ctx, keycloakClient, realmId, identity["service_account_client_id"])
+		if err != nil {
+			return "", err
+		}
+
+		roleClientId := identity["role_client_id"]
+		clientId, err := clientIdFromClientId(ctx, keycloakClient, realmId, roleClientId)
+		if err != nil {
+			return "", err
+		}
+
+		roleId, err := roleIdFromName(ctx, keycloakClient, realmId, roleClientId, identity["role"])
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%s/%s/%s/%s", realmId, serviceAccountUserId, clientId, roleId), nil
+	},
+	values: func(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) (map[string]string, error) {
+		realmId := data.Get("realm_id").(string)
+
+		serviceAccountClientId, err := serviceAccountClientIdFromUserId(ctx, keycloakClient, realmId, data.Get("service_account_user_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		roleClientId, err := clientIdFromId(ctx, keycloakClient, realmId, data.Get("client_id").(string))
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{
+			"realm_id":                  realmId,
+			"service_account_client_id": serviceAccountClientId,
+			"role_client_id":            roleClientId,
+			"role":                      data.Get("role").(string),
+		}, nil
+	},
+}
+
+var clientResourceIdentity = namedResourceIdentity("realm_id", "client_id", true, clientIdFromClientId)
+var clientScopeResourceIdentity = namedResourceIdentity("realm_id", "name", true, clientScopeIdFromName)
+var userFederationResourceIdentity = namedResourceIdentity("realm_id", "name", true, userFederationIdFromName)
+
+// ldap user federations can also be imported along with their bind credentials, which are not read from Keycloak
+var ldapUserFederationResourceIdentity = userFederationResourceIdentity.withImportIdFormats(
+	importIdFormat{format: "{{realm_id}}/{{name}}"},
+	importIdFormat{format: "{{realm_id}}/{{name}}/{{bind_credential}}"},
+)
+var keystoreResourceIdentity = namedResourceIdentity("realm_id", "name", true, keystoreIdFromName)
+var identityProviderResourceIdentity = naturalKeyResourceIdentity("realm", "alias")
+
+// resourceIdentities lists the identity of every resource of the provider, by resource type
+var resourceIdentities = map[string]*resourceIdentity{
+	"keycloak_realm":                                           realmScopedResourceIdentity("realm"),
+	"keycloak_realm_events":                                    realmScopedResourceIdentity("realm_id"),
+	"keycloak_realm_default_client_scopes":                     realmScopedResourceIdentity("realm_id"),
+	"keycloak_realm_optional_client_scopes":                    realmScopedResourceIdentity("realm_id"),
+	"keycloak_realm_client_policy_profile":                     naturalKeyResourceIdentity("realm_id", "name"),
+	"keycloak_realm_client_policy_profile_policy":              naturalKeyResourceIdentity("realm_id", "name"),
+	"keycloak_realm_keystore_aes_generated":                    keystoreResourceIdentity,
+	"keycloak_realm_keystore_ecdsa_generated":                  keystoreResourceIdentity,
+	"keycloak_realm_keystore_hmac_generated":                   keystoreResourceIdentity,
+	"keycloak_realm_keystore_java_keystore":                    keystoreResourceIdentity,
+	"keycloak_realm_keystore_rsa":                              keystoreResourceIdentity,
+	"keycloak_realm_keystore_rsa_generated":                    keystoreResourceIdentity,
+	"keycloak_realm_keystore_rsa_enc_generated":                keystoreResourceIdentity,
+	"keycloak_realm_user_profile":                              realmScopedResourceIdentity("realm_id"),
+	"keycloak_realm_localization":                              naturalKeyResourceIdentity("realm_id", "locale"),
+	"keycloak_required_action":                                 naturalKeyResourceIdentity("realm_id", "alias"),
+	"keycloak_group":                                           groupResourceIdentity,
+	"keycloak_group_memberships":                               groupAttachedResourceIdentity,
+	"keycloak_default_groups":                                  realmScopedResourceIdentity("realm_id"),
+	"keycloak_default_roles":                                   defaultRolesResourceIdentity,
+	"keycloak_group_roles":                                     groupAttachedResourceIdentity,
+	"keycloak_user":                                            naturalKeyResourceIdentity("realm_id", "username"),
+	"keycloak_user_roles":                                      userAttachedResourceIdentity,
+	"keycloak_openid_client":                                   clientResourceIdentity,
+	"keycloak_openid_client_scope":                             clientScopeResourceIdentity,
+	"keycloak_ldap_user_federation":                            ldapUserFederationResourceIdentity,
+	"keycloak_ldap_user_attribute_mapper":                      ldapMapperResourceIdentity,
+	"keycloak_ldap_certificate_mapper":                         ldapMapperResourceIdentity,
+	"keycloak_ldap_kerberos_principal_attribute_mapper":        ldapMapperResourceIdentity,
+	"keycloak_hardcoded_attribute_mapper":                      ldapMapperResourceIdentity,
+	"keycloak_ldap_group_mapper":                               ldapMapperResourceIdentity,
+	"keycloak_ldap_role_mapper":                                ldapMapperResourceIdentity,
+	"keycloak_ldap_hardcoded_role_mapper":                      ldapMapperResourceIdentity,
+	"keycloak_ldap_hardcoded_attribute_mapper":                 ldapMapperResourceIdentity,
+	"keycloak_ldap_hardcoded_group_mapper":                     ldapMapperResourceIdentity,
+	"keycloak_ldap_msad_user_account_control_mapper":           ldapMapperResourceIdentity,
+	"keycloak_ldap_msad_lds_user_account_control_mapper":       ldapMapperResourceIdentity,
+	"keycloak_ldap_full_name_mapper":                           ldapMapperResourceIdentity,
+	"keycloak_ldap_custom_mapper":                              ldapMapperResourceIdentity,
+	"keycloak_custom_user_federation":                          userFederationResourceIdentity,
+	"keycloak_kerberos_user_federation":                        userFederationResourceIdentity,
+	"keycloak_component":                                       componentResourceIdentity,
+	"keycloak_client_registration_policy":                      clientRegistrationPolicyResourceIdentity,
+	"keycloak_openid_user_attribute_protocol_mapper":           protocolMapperResourceIdentity,
+	"keycloak_openid_user_property_protocol_mapper":            protocolMapperResourceIdentity,
+	"keycloak_openid_group_membership_protocol_mapper":         protocolMapperResourceIdentity,
+	"keycloak_openid_full_name_protocol_mapper":                protocolMapperResourceIdentity,
+	"keycloak_openid_hardcoded_claim_protocol_mapper":          protocolMapperResourceIdentity,
+	"keycloak_openid_audience_protocol_mapper":                 protocolMapperResourceIdentity,
+	"keycloak_openid_audience_resolve_protocol_mapper":         protocolMapperResourceIdentity,
+	"keycloak_openid_hardcoded_role_protocol_mapper":           protocolMapperResourceIdentity,
+	"keycloak_openid_user_realm_role_protocol_mapper":          protocolMapperResourceIdentity,
+	"keycloak_openid_user_client_role_protocol_mapper":         protocolMapperResourceIdentity,
+	"keycloak_openid_user_session_note_protocol_mapper":        protocolMapperResourceIdentity,
+	"keycloak_openid_script_protocol_mapper":                   protocolMapperResourceIdentity,
+	"keycloak_openid_sha256_pairwise_sub_protocol_mapper":      protocolMapperResourceIdentity,
+	"keycloak_openid_acr_protocol_mapper":                      protocolMapperResourceIdentity,
+	"keycloak_openid_claims_parameter_protocol_mapper":         protocolMapperResourceIdentity,
+	"keycloak_openid_allowed_origins_protocol_mapper":          protocolMapperResourceIdentity,
+	"keycloak_openid_address_protocol_mapper":                  protocolMapperResourceIdentity,
+	"keycloak_openid_role_name_protocol_mapper":                protocolMapperResourceIdentity,
+	"keycloak_openid_organization_membership_protocol_mapper":  protocolMapperResourceIdentity,
+	"keycloak_openid_client_default_scopes":                    clientAttachedResourceIdentity,
+	"keycloak_openid_client_optional_scopes":                   clientAttachedResourceIdentity,
+	"keycloak_organization":                                    namedResourceIdentity("realm", "name", false, organizationIdFromName),
+	"keycloak_organization_members":                            organizationAttachedResourceIdentity,
+	"keycloak_saml_client":                                     clientResourceIdentity,
+	"keycloak_saml_client_scope":                               clientScopeResourceIdentity,
+	"keycloak_saml_client_default_scopes":                      clientAttachedResourceIdentity,
+	"keycloak_generic_client_protocol_mapper":                  protocolMapperResourceIdentity,
+	"keycloak_generic_client_role_mapper":                      roleMapperResourceIdentity,
+	"keycloak_generic_protocol_mapper":                         protocolMapperResourceIdentity,
+	"keycloak_generic_role_mapper":                             roleMapperResourceIdentity,
+	"keycloak_saml_user_attribute_protocol_mapper":             protocolMapperResourceIdentity,
+	"keycloak_saml_user_property_protocol_mapper":              protocolMapperResourceIdentity,
+	"keycloak_saml_script_protocol_mapper":                     protocolMapperResourceIdentity,
+	"keycloak_saml_role_list_protocol_mapper":                  protocolMapperResourceIdentity,
+	"keycloak_saml_group_membership_protocol_mapper":           protocolMapperResourceIdentity,
+	"keycloak_saml_hardcoded_attribute_protocol_mapper":        protocolMapperResourceIdentity,
+	"keycloak_saml_hardcoded_role_protocol_mapper":             protocolMapperResourceIdentity,
+	"keycloak_saml_role_name_protocol_mapper":                  protocolMapperResourceIdentity,
+	"keycloak_saml_audience_protocol_mapper":                   protocolMapperResourceIdentity,
+	"keycloak_hardcoded_attribute_identity_provider_mapper":    identityProviderMapperResourceIdentity,
+	"keycloak_hardcoded_group_identity_provider_mapper":        identityProviderMapperResourceIdentity,
+	"keycloak_hardcoded_role_identity_provider_mapper":         identityProviderMapperResourceIdentity,
+	"keycloak_attribute_importer_identity_provider_mapper":     identityProviderMapperResourceIdentity,
+	"keycloak_attribute_to_role_identity_provider_mapper":      identityProviderMapperResourceIdentity,
+	"keycloak_user_template_importer_identity_provider_mapper": identityProviderMapperResourceIdentity,
+	"keycloak_custom_identity_provider_mapper":                 identityProviderMapperResourceIdentity,
+	"keycloak_oidc_advanced_role_identity_provider_mapper":     identityProviderMapperResourceIdentity,
+	"keycloak_oidc_advanced_group_identity_provider_mapper":    identityProviderMapperResourceIdentity,
+	"keycloak_saml_advanced_role_identity_provider_mapper":     identityProviderMapperResourceIdentity,
+	"keycloak_saml_xpath_attribute_identity_provider_mapper":   identityProviderMapperResourceIdentity,
+	"keycloak_oidc_username_identity_provider_mapper":          identityProviderMapperResourceIdentity,
+	"keycloak_saml_identity_provider":                          identityProviderResourceIdentity,
+	"keycloak_oidc_google_identity_provider":                   identityProviderResourceIdentity,
+	"keycloak_github_identity_provider":                        identityProviderResourceIdentity,
+	"keycloak_gitlab_identity_provider":                        identityProviderResourceIdentity,
+	"keycloak_microsoft_identity_provider":                     identityProviderResourceIdentity,
+	"keycloak_facebook_identity_provider":                      identityProviderResourceIdentity,
+	"keycloak_linkedin_identity_provider":                      identityProviderResourceIdentity,
+	"keycloak_bitbucket_identity_provider":                     identityProviderResourceIdentity,
+	"keycloak_paypal_identity_provider":                        identityProviderResourceIdentity,
+	"keycloak_stackoverflow_identity_provider":                 identityProviderResourceIdentity,
+	"keycloak_instagram_identity_provider":                     identityProviderResourceIdentity,
+	"keycloak_twitter_identity_provider":                       identityProviderResourceIdentity,
+	"keycloak_oidc_identity_provider":                          identityProviderResourceIdentity,
+	"keycloak_kubernetes_identity_provider":                    identityProviderResourceIdentity,
+	"keycloak_jwt_bearer_identity_provider":                    identityProviderResourceIdentity,
+	"keycloak_openid_client_authorization_resource":            authorizationResourceIdentity(authorizationResourceIdFromName),
+	"keycloak_openid_client_group_policy":                      authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_role_policy":                       authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_aggregate_policy":                  authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_js_policy":                         authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_time_policy":                       authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_regex_policy":                      authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_user_policy":                       authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_client_policy":                     authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_authorization_client_scope_policy": authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_authorization_scope":               authorizationResourceIdentity(authorizationScopeIdFromName),
+	"keycloak_openid_client_authorization_permission":          authorizationResourceIdentity(authorizationPolicyIdFromName),
+	"keycloak_openid_client_service_account_role":              serviceAccountClientRoleResourceIdentity,
+	"keycloak_openid_client_service_account_realm_role":        serviceAccountRealmRoleResourceIdentity,
+	"keycloak_role":                                              roleResourceIdentity,
+	"keycloak_authentication_flow":                               namedResourceIdentity("realm_id", "alias", true, authenticationFlowIdFromAlias),
+	"keycloak_authentication_flow_tree":                          namedResourceIdentity("realm_id", "alias", true, authenticationFlowIdFromAlias),
+	"keycloak_authentication_subflow":                            authenticationSubFlowResourceIdentity,
+	"keycloak_authentication_execution":                          authenticationExecutionResourceIdentity,
+	"keycloak_authentication_execution_config":                   authenticationExecutionConfigResourceIdentity,
+	"keycloak_identity_provider_token_exchange_scope_permission": naturalKeyResourceIdentity("realm_id", "provider_alias"),
+	"keycloak_openid_client_permissions":                         clientAttachedResourceIdentity,
+	"keycloak_admin_permission":                                  namedResourceIdentity("realm_id", "name", true, adminPermissionIdFromName),
+	"keycloak_users_permissions":                                 realmScopedResourceIdentity("realm_id"),
+	"keycloak_user_groups":                                       userAttachedResourceIdentity,
+	"keycloak_group_permissions":                                 groupAttachedResourceIdentity,
+	"keycloak_authentication_bindings":                           realmScopedResourceIdentity("realm_id"),
+}
+
+// resourcesWithoutIdentity lists the resources which are deliberately left without identity, as they can't be imported:
+// they either trigger an action, or hold values which can't be read back from Keycloak.
+var resourcesWithoutIdentity = []string{
+	"keycloak_client_initial_access_token",
+	"keycloak_openid_client_certificate",
+	"keycloak_ldap_user_federation_sync",
+	"keycloak_ldap_mapper_sync",
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakAdminPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakAdminPermissionCreate,
+		ReadContext:   resourceKeycloakAdminPermissionRead,
+		DeleteContext: resourceKeycloakAdminPermissionDelete,
+		UpdateContext: resourceKeycloakAdminPermissionUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakAdminPermissionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_server_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the admin-permissions client on which this permission is managed",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(keycloak.AdminPermissionResourceTypes, false),
+			},
+			"resources": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Ids of the objects this permission applies to, all the objects of the resource type when empty",
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+				MinItems: 1,
+			},
+			"policies": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"decision_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+				Default:      "UNANIMOUS",
+			},
+		},
+	}
+}
+
+func getAdminPermissionFromData(data *schema.ResourceData) *keycloak.AdminPermission {
+	return &keycloak.AdminPermission{
+		Id:               data.Id(),
+		RealmId:          data.Get("realm_id").(string),
+		ResourceServerId: data.Get("resource_server_id").(string),
+		Name:             data.Get("name").(string),
+		Description:      data.Get("description").(string),
+		DecisionStrategy: data.Get("decision_strategy").(string),
+		ResourceType:     data.Get("resource_type").(string),
+		Resources:        interfaceSliceToStringSlice(data.Get("resources").(*schema.Set).List()),
+		Scopes:           interfaceSliceToStringSlice(data.Get("scopes").(*schema.Set).List()),
+		Policies:         interfaceSliceToStringSlice(data.Get("policies").(*schema.Set).List()),
+	}
+}
+
+func setAdminPermissionData(data *schema.ResourceData, permission *keycloak.AdminPermission) {
+	data.SetId(permission.Id)
+	data.Set("realm_id", permission.RealmId)
+	data.Set("resource_server_id", permission.ResourceServerId)
+	data.Set("name", permission.Name)
+	data.Set("description", permission.Description)
+	data.Set("decision_strategy", permission.DecisionStrategy)
+	data.Set("resource_type", permission.ResourceType)
+	data.Set("resources", permission.Resources)
+	data.Set("scopes", permission.Scopes)
+	data.Set("policies", permission.Policies)
+}
+
+func resourceKeycloakAdminPermissionCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	permission := getAdminPermissionFromData(data)
+
+	resourceServerId, err := getAdminPermissionsResourceServerId(ctx, keycloakClient, permission.RealmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission.ResourceServerId = resourceServerId
+
+	err = keycloakClient.NewAdminPermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setAdminPermissionData(data, permission)
+
+	return resourceKeycloakAdminPermissionRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminPermissionRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	// the admin-permissions client is looked up when importing, and removed along with the permissions when they are disabled
+	if resourceServerId == "" {
+		adminPermissionsClient, err := keycloakClient.GetAdminPermissionsClient(ctx, realmId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		resourceServerId = adminPermissionsClient.Id
+	}
+
+	permission, err := keycloakClient.GetAdminPermission(ctx, realmId, resourceServerId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setAdminPermissionData(data, permission)
+
+	return nil
+}
+
+func resourceKeycloakAdminPermissionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	permission := getAdminPermissionFromData(data)
+
+	err := keycloakClient.UpdateAdminPermission(ctx, permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakAdminPermissionRead(ctx, data, meta)
+}
+
+func resourceKeycloakAdminPermissionDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteAdminPermission(ctx, realmId, resourceServerId, data.Id()))
+}
+
+func resourceKeycloakAdminPermissionImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{permissionId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
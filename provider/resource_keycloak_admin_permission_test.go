@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakAdminPermission_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_2)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	permissionName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_admin_permission.permission"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAdminPermissionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAdminPermission_specificUser(realmName, permissionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdminPermissionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_server_id", "data.keycloak_admin_permissions.permissions", "resource_server_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "Users"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resources.*", "keycloak_user.managed", "id"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "view"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "manage"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policies.*", "keycloak_openid_client_user_policy.admins", "id"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: realmName + "/",
+			},
+			{
+				Config: testKeycloakAdminPermission_allUsers(realmName, permissionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAdminPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "scopes.*", "view"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakAdminPermissionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getAdminPermissionFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakAdminPermissionDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_admin_permission" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			resourceServerId := rs.Primary.Attributes["resource_server_id"]
+
+			permission, _ := keycloakClient.GetAdminPermission(testCtx, realmId, resourceServerId, rs.Primary.ID)
+			if permission != nil {
+				return fmt.Errorf("admin permission with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getAdminPermissionFromState(s *terraform.State, resourceName string) (*keycloak.AdminPermission, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realmId := rs.Primary.Attributes["realm_id"]
+	resourceServerId := rs.Primary.Attributes["resource_server_id"]
+
+	permission, err := keycloakClient.GetAdminPermission(testCtx, realmId, resourceServerId, rs.Primary.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting admin permission with id %s: %s", rs.Primary.ID, err)
+	}
+
+	return permission, nil
+}
+
+func testKeycloakAdminPermission_policy(realmName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                     = "%s"
+	admin_permissions_enabled = true
+}
+
+data "keycloak_admin_permissions" "permissions" {
+	realm_id = keycloak_realm.realm.id
+}
+
+resource "keycloak_user" "admin" {
+	realm_id = keycloak_realm.realm.id
+	username = "admin"
+}
+
+resource "keycloak_user" "managed" {
+	realm_id = keycloak_realm.realm.id
+	username = "managed"
+}
+
+resource "keycloak_openid_client_user_policy" "admins" {
+	realm_id           = keycloak_realm.realm.id
+	resource_server_id = data.keycloak_admin_permissions.permissions.resource_server_id
+	name               = "admins"
+	users              = [keycloak_user.admin.id]
+	logic              = "POSITIVE"
+	decision_strategy  = "UNANIMOUS"
+}
+	`, realmName)
+}
+
+func testKeycloakAdminPermission_specificUser(realmName, permissionName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_admin_permission" "permission" {
+	realm_id      = keycloak_realm.realm.id
+	name          = "%s"
+	description   = "admins can view and manage the managed user"
+	resource_type = "Users"
+	resources     = [keycloak_user.managed.id]
+	scopes        = ["view", "manage"]
+	policies      = [keycloak_openid_client_user_policy.admins.id]
+}
+	`, testKeycloakAdminPermission_policy(realmName), permissionName)
+}
+
+func testKeycloakAdminPermission_allUsers(realmName, permissionName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_admin_permission" "permission" {
+	realm_id      = keycloak_realm.realm.id
+	name          = "%s"
+	resource_type = "Users"
+	scopes        = ["view"]
+	policies      = [keycloak_openid_client_user_policy.admins.id]
+}
+	`, testKeycloakAdminPermission_policy(realmName), permissionName)
+}
@@ -14,6 +14,9 @@ func resourceKeycloakAuthenticationBindings() *schema.Resource {
 		ReadContext:   resourceKeycloakAuthenticationBindingsRead,
 		DeleteContext: resourceKeycloakAuthenticationBindingsDelete,
 		UpdateContext: resourceKeycloakAuthenticationBindingsUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakAuthenticationBindingsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -192,3 +195,9 @@ func resourceKeycloakAuthenticationBindingsUpdate(ctx context.Context, data *sch
 
 	return resourceKeycloakAuthenticationBindingsRead(ctx, data, meta)
 }
+
+func resourceKeycloakAuthenticationBindingsImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -21,6 +21,7 @@ func resourceKeycloakAuthenticationExecution() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakAuthenticationExecutionImport,
 		},
+		CustomizeDiff: validateAuthenticationExecutionPriority,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -44,13 +45,38 @@ func resourceKeycloakAuthenticationExecution() *schema.Resource {
 				Default:      "DISABLED",
 			},
 			"priority": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Executions with lower priorities run first",
 			},
 		},
 	}
 }
 
+// validateAuthenticationExecutionPriority rejects a configured priority on Keycloak versions older than 25, which ignore
+// it. Executions can't be ordered reliably there without knowing the priorities of all their siblings, which only the
+// flow tree resource does.
+func validateAuthenticationExecutionPriority(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || rawConfig.GetAttr("priority").IsNull() {
+		return nil
+	}
+
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	versionOk, err := keycloakClient.VersionIsLessThan(ctx, keycloak.Version_25)
+	if err != nil {
+		return err
+	}
+
+	if versionOk {
+		return fmt.Errorf("priority is only supported by Keycloak 25 and higher, use keycloak_authentication_flow_tree to order the executions of a flow on older versions")
+	}
+
+	return nil
+}
+
 func mapFromDataToAuthenticationExecution(data *schema.ResourceData) *keycloak.AuthenticationExecution {
 	authenticationExecution := &keycloak.AuthenticationExecution{
 		Id:              data.Id(),
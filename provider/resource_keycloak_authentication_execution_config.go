@@ -20,6 +20,7 @@ func resourceKeycloakAuthenticationExecutionConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakAuthenticationExecutionConfigImport,
 		},
+		CustomizeDiff: resourceKeycloakAuthenticationExecutionConfigValidateConfig,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -41,10 +42,98 @@ func resourceKeycloakAuthenticationExecutionConfig() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Required: true,
 			},
+			"authenticator": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Authenticator of the execution, used to validate the config keys while the execution is not known yet",
+			},
 		},
 	}
 }
 
+// resourceKeycloakAuthenticationExecutionConfigValidateConfig checks the config keys against the config properties of
+// the authenticator of the execution, or of the authenticator argument while the execution is not known yet. The
+// validation is skipped when neither is known, and done again when the config is applied.
+func resourceKeycloakAuthenticationExecutionConfigValidateConfig(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("config", "authenticator") || !diff.NewValueKnown("realm_id") || !diff.NewValueKnown("config") || !diff.NewValueKnown("authenticator") {
+		return nil
+	}
+
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := diff.Get("realm_id").(string)
+	authenticator := diff.Get("authenticator").(string)
+
+	if diff.NewValueKnown("execution_id") {
+		execution, err := getAuthenticationExecutionConfigExecution(ctx, keycloakClient, realmId, diff.Get("execution_id").(string), authenticator)
+		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		authenticator = execution.Authenticator
+	}
+
+	return validateAuthenticationExecutionConfigKeys(ctx, keycloakClient, realmId, authenticator, diff.Get("config").(map[string]interface{}))
+}
+
+// getAuthenticationExecutionConfigExecution returns the execution a config is attached to, checking that it runs the
+// authenticator of the config when it is set
+func getAuthenticationExecutionConfigExecution(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, executionId, authenticator string) (*keycloak.AuthenticationExecution, error) {
+	execution, err := keycloakClient.GetAuthenticationExecution(ctx, realmId, "", executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if authenticator != "" && execution.Authenticator != authenticator {
+		return nil, fmt.Errorf("validation error: authenticator %s doesn't match the authenticator %s of execution %s", authenticator, execution.Authenticator, executionId)
+	}
+
+	return execution, nil
+}
+
+func validateAuthenticationExecutionConfigKeys(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, authenticator string, config map[string]interface{}) error {
+	if authenticator == "" {
+		return nil
+	}
+
+	configDescription, err := keycloakClient.GetAuthenticatorConfigDescription(ctx, realmId, authenticator)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	var keys []string
+	for key := range config {
+		keys = append(keys, key)
+	}
+
+	if unknownProperties := configDescription.UnknownProperties(keys); len(unknownProperties) != 0 {
+		return fmt.Errorf("validation error: config keys %s are not supported by authenticator %s, supported keys are: %s", strings.Join(unknownProperties, ", "), authenticator, strings.Join(configDescription.PropertyNames(), ", "))
+	}
+
+	return nil
+}
+
+// validateAuthenticationExecutionConfigData validates the config when it is applied, as the execution may not have been
+// known when the config was planned
+func validateAuthenticationExecutionConfigData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) error {
+	realmId := data.Get("realm_id").(string)
+
+	execution, err := getAuthenticationExecutionConfigExecution(ctx, keycloakClient, realmId, data.Get("execution_id").(string), data.Get("authenticator").(string))
+	if err != nil {
+		return err
+	}
+
+	return validateAuthenticationExecutionConfigKeys(ctx, keycloakClient, realmId, execution.Authenticator, data.Get("config").(map[string]interface{}))
+}
+
 func getAuthenticationExecutionConfigFromData(data *schema.ResourceData) *keycloak.AuthenticationExecutionConfig {
 	config := make(map[string]string)
 	for key, value := range data.Get("config").(map[string]interface{}) {
@@ -70,6 +159,11 @@ func setAuthenticationExecutionConfigData(data *schema.ResourceData, config *key
 func resourceKeycloakAuthenticationExecutionConfigCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	err := validateAuthenticationExecutionConfigData(ctx, keycloakClient, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	config := getAuthenticationExecutionConfigFromData(data)
 
 	id, err := keycloakClient.NewAuthenticationExecutionConfig(ctx, config)
@@ -104,9 +198,14 @@ func resourceKeycloakAuthenticationExecutionConfigRead(ctx context.Context, data
 func resourceKeycloakAuthenticationExecutionConfigUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	err := validateAuthenticationExecutionConfigData(ctx, keycloakClient, data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	config := getAuthenticationExecutionConfigFromData(data)
 
-	err := keycloakClient.UpdateAuthenticationExecutionConfig(ctx, config)
+	err = keycloakClient.UpdateAuthenticationExecutionConfig(ctx, config)
 	if err != nil {
 		return diag.FromErr(err)
 	}
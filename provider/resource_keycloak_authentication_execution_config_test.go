@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -50,6 +51,58 @@ func TestAccKeycloakAuthenticationExecutionConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakAuthenticationExecutionConfig_unknownConfigKey(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	configAlias := acctest.RandomWithPrefix("tf-acc")
+	configProvider := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakAuthenticationExecutionConfig(flowAlias, configAlias, configProvider),
+				Check:  testAccCheckKeycloakAuthenticationExecutionConfigExists("keycloak_authentication_execution_config.config", &keycloak.AuthenticationExecutionConfig{}),
+			},
+			{
+				// the execution is known once it exists, so the config is validated against its authenticator
+				Config:      testAccKeycloakAuthenticationExecutionConfig_unknownConfigKey(flowAlias, configAlias, configProvider),
+				ExpectError: regexp.MustCompile("config keys defaultIdp are not supported by authenticator identity-provider-redirector"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationExecutionConfig_unknownConfigKeyWithNewExecution(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	configAlias := acctest.RandomWithPrefix("tf-acc")
+	configProvider := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the authenticator argument is known from the configuration, so the config is validated when planned
+				Config:      testAccKeycloakAuthenticationExecutionConfig_unknownConfigKeyWithAuthenticator(flowAlias, configAlias, configProvider),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("config keys defaultIdp are not supported by authenticator identity-provider-redirector"),
+			},
+			{
+				// otherwise, it is validated once the execution exists, before the config is created
+				Config:      testAccKeycloakAuthenticationExecutionConfig_unknownConfigKey(flowAlias, configAlias, configProvider),
+				ExpectError: regexp.MustCompile("config keys defaultIdp are not supported by authenticator identity-provider-redirector"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakAuthenticationExecutionConfig_updateForcesNew(t *testing.T) {
 	t.Parallel()
 
@@ -210,3 +263,58 @@ resource "keycloak_authentication_execution_config" "config" {
 	}
 }`, testAccRealm.Realm, flowAlias, configAlias, configProvider)
 }
+
+func testAccKeycloakAuthenticationExecutionConfig_unknownConfigKey(flowAlias, configAlias, configProvider string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "execution" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "identity-provider-redirector"
+}
+
+resource "keycloak_authentication_execution_config" "config" {
+	realm_id     = data.keycloak_realm.realm.id
+	execution_id = keycloak_authentication_execution.execution.id
+	alias        = "%s"
+	config = {
+		defaultIdp = "%s"
+	}
+}`, testAccRealm.Realm, flowAlias, configAlias, configProvider)
+}
+
+func testAccKeycloakAuthenticationExecutionConfig_unknownConfigKeyWithAuthenticator(flowAlias, configAlias, configProvider string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "execution" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "identity-provider-redirector"
+}
+
+resource "keycloak_authentication_execution_config" "config" {
+	realm_id      = data.keycloak_realm.realm.id
+	execution_id  = keycloak_authentication_execution.execution.id
+	authenticator = keycloak_authentication_execution.execution.authenticator
+	alias         = "%s"
+	config = {
+		defaultIdp = "%s"
+	}
+}`, testAccRealm.Realm, flowAlias, configAlias, configProvider)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -33,6 +34,35 @@ func TestAccKeycloakAuthenticationExecution_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakAuthenticationExecution_importFromIdentityWithIndex(t *testing.T) {
+	t.Parallel()
+	parentAuthFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationExecutionDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationExecution_sameAuthenticator(parentAuthFlowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceImportableFromIdentity("keycloak_authentication_execution.first", map[string]string{
+						"realm_id":          testAccRealm.Realm,
+						"parent_flow_alias": parentAuthFlowAlias,
+						"authenticator":     "auth-cookie",
+					}),
+					testAccCheckResourceImportableFromIdentity("keycloak_authentication_execution.second", map[string]string{
+						"realm_id":          testAccRealm.Realm,
+						"parent_flow_alias": parentAuthFlowAlias,
+						"authenticator":     "auth-cookie",
+						"index":             "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakAuthenticationExecution_createAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 	var authenticationExecution = &keycloak.AuthenticationExecution{}
@@ -189,6 +219,74 @@ func TestAccKeycloakAuthenticationExecution_updateAuthenticationExecutionPriorit
 	})
 }
 
+func TestAccKeycloakAuthenticationExecution_priorityDrift(t *testing.T) {
+	t.Parallel()
+
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	authParentFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationSubFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationExecution_multipleExecutionsWithPriority(authParentFlowAlias, 200, 201),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationExecutionIndex("keycloak_authentication_execution.cookie_execution", 0),
+					testAccCheckKeycloakAuthenticationExecutionIndex("keycloak_authentication_execution.kerberos_execution", 1),
+				),
+			},
+			{
+				// reordering the executions in the console is reverted
+				PreConfig: func() {
+					kerberosExecution, err := keycloakClient.GetAuthenticationExecutionInfoFromProviderId(testCtx, testAccRealm.Realm, authParentFlowAlias, "auth-spnego")
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = keycloakClient.RaiseAuthenticationExecutionPriority(testCtx, testAccRealm.Realm, kerberosExecution.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakAuthenticationExecution_multipleExecutionsWithPriority(authParentFlowAlias, 200, 201),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_authentication_execution.cookie_execution", "priority", "200"),
+					testAccCheckKeycloakAuthenticationExecutionIndex("keycloak_authentication_execution.cookie_execution", 0),
+					resource.TestCheckResourceAttr("keycloak_authentication_execution.kerberos_execution", "priority", "201"),
+					testAccCheckKeycloakAuthenticationExecutionIndex("keycloak_authentication_execution.kerberos_execution", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationExecution_priorityBefore25(t *testing.T) {
+	t.Parallel()
+
+	if ok, _ := keycloakClient.VersionIsLessThan(testCtx, keycloak.Version_25); !ok {
+		t.Skip()
+	}
+
+	authParentFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationSubFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakAuthenticationExecution_multipleExecutionsWithPriority(authParentFlowAlias, 10, 20),
+				ExpectError: regexp.MustCompile("priority is only supported by Keycloak 25 and higher"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakAuthenticationExecutionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getAuthenticationExecutionFromState(s, resourceName)
@@ -303,6 +401,35 @@ func getExecutionImportId(resourceName string) resource.ImportStateIdFunc {
 	}
 }
 
+func testKeycloakAuthenticationExecution_sameAuthenticator(parentAlias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+}
+
+resource "keycloak_authentication_execution" "first" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "auth-cookie"
+	requirement       = "ALTERNATIVE"
+}
+
+resource "keycloak_authentication_execution" "second" {
+	realm_id          = data.keycloak_realm.realm.id
+	parent_flow_alias = keycloak_authentication_flow.flow.alias
+	authenticator     = "auth-cookie"
+	requirement       = "ALTERNATIVE"
+
+	depends_on = [keycloak_authentication_execution.first]
+}
+	`, testAccRealm.Realm, parentAlias)
+}
+
 func testKeycloakAuthenticationExecution_basic(parentAlias string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
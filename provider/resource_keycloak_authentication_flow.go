@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+
+	"dario.cat/mergo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -40,10 +42,33 @@ func resourceKeycloakAuthenticationFlow() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"import": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ForceNew:      true,
+				ConflictsWith: []string{"copy_from"},
+			},
+			"keep_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"copy_from": authenticationFlowCopyFromSchema(),
 		},
 	}
 }
 
+func authenticationFlowCopyFromSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		// the copied flow can't be read back, so the attribute can be left out of the configuration of imported flows
+		Computed:    true,
+		ForceNew:    true,
+		Description: "Alias of the flow to copy, with its executions, when creating this flow",
+	}
+}
+
 func mapFromDataToAuthenticationFlow(data *schema.ResourceData) *keycloak.AuthenticationFlow {
 	authenticationFlow := &keycloak.AuthenticationFlow{
 		Id:          data.Id(),
@@ -75,9 +100,30 @@ func resourceKeycloakAuthenticationFlowCreate(ctx context.Context, data *schema.
 
 	authenticationFlow := mapFromDataToAuthenticationFlow(data)
 
-	err := keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
-	if err != nil {
-		return diag.FromErr(err)
+	if copyFrom := data.Get("copy_from").(string); copyFrom != "" {
+		err := keycloakClient.CopyAuthenticationFlow(ctx, copyFrom, authenticationFlow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if !data.Get("import").(bool) {
+		err := keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		existingAuthenticationFlow, err := keycloakClient.GetAuthenticationFlowFromAlias(ctx, authenticationFlow.RealmId, authenticationFlow.Alias)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = mergo.Merge(authenticationFlow, existingAuthenticationFlow); err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = keycloakClient.UpdateAuthenticationFlow(ctx, authenticationFlow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	mapFromAuthenticationFlowToData(data, authenticationFlow)
@@ -97,6 +143,11 @@ func resourceKeycloakAuthenticationFlowRead(ctx context.Context, data *schema.Re
 	}
 
 	mapFromAuthenticationFlowToData(data, authenticationFlow)
+
+	if _, ok := data.GetOk("import"); !ok {
+		data.Set("import", false)
+	}
+
 	return nil
 }
 
@@ -115,6 +166,10 @@ func resourceKeycloakAuthenticationFlowUpdate(ctx context.Context, data *schema.
 }
 
 func resourceKeycloakAuthenticationFlowDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if keepOnDestroy(data.GetRawState()) {
+		return nil
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
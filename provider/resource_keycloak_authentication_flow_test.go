@@ -93,6 +93,138 @@ func TestAccKeycloakAuthenticationFlow_updateAuthenticationFlow(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakAuthenticationFlow_copyFrom(t *testing.T) {
+	t.Parallel()
+
+	authFlowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlow_copyFrom(authFlowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowExists("keycloak_authentication_flow.flow"),
+					testAccCheckKeycloakAuthenticationFlowHasExecution("keycloak_authentication_flow.flow", "auth-cookie"),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow.flow", "description", "copy of the browser flow"),
+				),
+			},
+			{
+				ResourceName:            "keycloak_authentication_flow.flow",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     testAccRealm.Realm + "/",
+				ImportStateVerifyIgnore: []string{"copy_from"},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlow_addCopyFrom(t *testing.T) {
+	t.Parallel()
+
+	authFlowAlias := acctest.RandomWithPrefix("tf-acc")
+	authenticationFlow := &keycloak.AuthenticationFlow{}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlow_basic(authFlowAlias),
+				Check:  testAccCheckKeycloakAuthenticationFlowFetch("keycloak_authentication_flow.flow", authenticationFlow),
+			},
+			{
+				// adding copy_from to an existing flow recreates it as a copy
+				Config: testKeycloakAuthenticationFlow_copyFrom(authFlowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						flow, err := getAuthenticationFlowFromState(s, "keycloak_authentication_flow.flow")
+						if err != nil {
+							return err
+						}
+
+						if flow.Id == authenticationFlow.Id {
+							return fmt.Errorf("expected the flow to be recreated when copy_from is added, but it kept the id %s", flow.Id)
+						}
+
+						return nil
+					},
+					testAccCheckKeycloakAuthenticationFlowHasExecution("keycloak_authentication_flow.flow", "auth-cookie"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlow_adopt(t *testing.T) {
+	t.Parallel()
+
+	existingFlow := &keycloak.AuthenticationFlow{
+		RealmId:     testAccRealm.Realm,
+		Alias:       acctest.RandomWithPrefix("tf-acc"),
+		ProviderId:  "basic-flow",
+		Description: "created outside of terraform",
+		TopLevel:    true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowNotDestroyed(),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					err := keycloakClient.NewAuthenticationFlow(testCtx, existingFlow)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					t.Cleanup(func() {
+						keycloakClient.DeleteAuthenticationFlow(testCtx, existingFlow.RealmId, existingFlow.Id)
+					})
+				},
+				// the description of the adopted flow is updated
+				Config: testKeycloakAuthenticationFlow_adopt(existingFlow.Alias, "adopted by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("keycloak_authentication_flow.flow", "id", &existingFlow.Id),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow.flow", "description", "adopted by terraform"),
+					testAccCheckKeycloakAuthenticationFlowDescription("keycloak_authentication_flow.flow", "adopted by terraform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlow_adoptBuiltIn(t *testing.T) {
+	t.Parallel()
+
+	browserFlow, err := keycloakClient.GetAuthenticationFlowFromAlias(testCtx, testAccRealm.Realm, "browser")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowNotDestroyed(),
+		Steps: []resource.TestStep{
+			{
+				// the flow is adopted with its own description, so the realm shared by the tests is left unchanged
+				Config: testKeycloakAuthenticationFlow_adopt("browser", browserFlow.Description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_authentication_flow.flow", "id", browserFlow.Id),
+					resource.TestCheckResourceAttr("keycloak_authentication_flow.flow", "description", browserFlow.Description),
+					testAccCheckKeycloakAuthenticationFlowHasExecution("keycloak_authentication_flow.flow", "auth-cookie"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakAuthenticationFlow_updateRealm(t *testing.T) {
 	t.Parallel()
 
@@ -181,6 +313,42 @@ func testAccCheckKeycloakAuthenticationFlowDestroy() resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckKeycloakAuthenticationFlowNotDestroyed checks that adopted flows are left in place
+func testAccCheckKeycloakAuthenticationFlowNotDestroyed() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_authentication_flow" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			authenticationFlow, _ := keycloakClient.GetAuthenticationFlow(testCtx, realm, id)
+			if authenticationFlow == nil {
+				return fmt.Errorf("authentication flow with id %s does not exist", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAuthenticationFlowDescription(resourceName, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		authenticationFlow, err := getAuthenticationFlowFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if authenticationFlow.Description != description {
+			return fmt.Errorf("expected authentication flow with id %s to have description %s, but got %s", authenticationFlow.Id, description, authenticationFlow.Description)
+		}
+
+		return nil
+	}
+}
+
 func getAuthenticationFlowFromState(s *terraform.State, resourceName string) (*keycloak.AuthenticationFlow, error) {
 	rs, ok := s.RootModule().Resources[resourceName]
 	if !ok {
@@ -198,6 +366,31 @@ func getAuthenticationFlowFromState(s *terraform.State, resourceName string) (*k
 	return authenticationFlow, nil
 }
 
+func testAccCheckKeycloakAuthenticationFlowHasExecution(resourceName, authenticator string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		alias := rs.Primary.Attributes["alias"]
+
+		executions, err := keycloakClient.ListAuthenticationExecutions(testCtx, realmId, alias)
+		if err != nil {
+			return err
+		}
+
+		for _, execution := range executions {
+			if execution.ProviderId == authenticator {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected flow %s to have an execution running %s", alias, authenticator)
+	}
+}
+
 func testKeycloakAuthenticationFlow_basic(alias string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -244,3 +437,33 @@ resource "keycloak_authentication_flow" "flow" {
 }
 	`, testAccRealm.Realm, testAccRealmTwo.Realm, alias)
 }
+
+func testKeycloakAuthenticationFlow_copyFrom(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "copy of the browser flow"
+	copy_from   = "browser"
+}
+	`, testAccRealm.Realm, alias)
+}
+
+func testKeycloakAuthenticationFlow_adopt(alias, description string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "%s"
+	import      = true
+}
+	`, testAccRealm.Realm, alias, description)
+}
@@ -0,0 +1,460 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// subflows can be nested up to this depth, as the schema of the nested executions can't be recursive
+const authenticationFlowTreeMaxDepth = 5
+
+func resourceKeycloakAuthenticationFlowTree() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakAuthenticationFlowTreeCreate,
+		ReadContext:   resourceKeycloakAuthenticationFlowTreeRead,
+		DeleteContext: resourceKeycloakAuthenticationFlowTreeDelete,
+		UpdateContext: resourceKeycloakAuthenticationFlowTreeUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakAuthenticationFlowTreeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Default:      "basic-flow",
+				ValidateFunc: validation.StringInSlice([]string{"basic-flow", "client-flow"}, false),
+				Optional:     true,
+				ForceNew:     true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"copy_from": authenticationFlowCopyFromSchema(),
+			"execution": authenticationFlowTreeExecutionsSchema(1),
+		},
+		CustomizeDiff: resourceKeycloakAuthenticationFlowTreeDiff,
+	}
+}
+
+func authenticationFlowTreeExecutionsSchema(depth int) *schema.Schema {
+	executionSchema := map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"authenticator": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Authenticator of the execution, required unless the execution is a subflow",
+		},
+		"requirement": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"REQUIRED", "ALTERNATIVE", "OPTIONAL", "CONDITIONAL", "DISABLED"}, false),
+			Default:      "DISABLED",
+		},
+		"config": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"alias": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"config": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if depth < authenticationFlowTreeMaxDepth {
+		executionSchema["subflow"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"alias": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"provider_id": {
+						Type:         schema.TypeString,
+						Default:      "basic-flow",
+						ValidateFunc: validation.StringInSlice([]string{"basic-flow", "form-flow", "client-flow"}, false),
+						Optional:     true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"execution": authenticationFlowTreeExecutionsSchema(depth + 1),
+				},
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		// the executions of the flow, such as the ones of a copied flow, are left as they are when none is declared
+		Computed: depth == 1,
+		Elem: &schema.Resource{
+			Schema: executionSchema,
+		},
+	}
+}
+
+// authenticationFlowTreeExecutionsDeclared returns whether the configuration declares the executions of the flow
+func authenticationFlowTreeExecutionsDeclared(rawConfig cty.Value) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	executions := rawConfig.GetAttr("execution")
+
+	return !executions.IsKnown() || (!executions.IsNull() && executions.LengthInt() != 0)
+}
+
+// resourceKeycloakAuthenticationFlowTreeDiff matches the declared executions with the existing ones by authenticator
+// or subflow alias instead of by position, so adding or moving an execution doesn't change the ids of the other ones
+func resourceKeycloakAuthenticationFlowTreeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("execution") || !authenticationFlowTreeExecutionsDeclared(d.GetRawConfig()) {
+		return nil
+	}
+
+	existingExecutions, executions := d.GetChange("execution")
+
+	return d.SetNew("execution", matchAuthenticationFlowTreeExecutionIds(executions.([]interface{}), existingExecutions.([]interface{})))
+}
+
+func authenticationFlowTreeExecutionKey(executionData map[string]interface{}) string {
+	if subFlows, ok := executionData["subflow"].([]interface{}); ok && len(subFlows) != 0 && subFlows[0] != nil {
+		return "subflow/" + subFlows[0].(map[string]interface{})["alias"].(string)
+	}
+
+	return "authenticator/" + executionData["authenticator"].(string)
+}
+
+// matchAuthenticationFlowTreeExecutionIds sets the ids of the executions, configs and subflows from the existing
+// executions running the same authenticator or subflow, in order, and leaves the ids of the new ones empty
+func matchAuthenticationFlowTreeExecutionIds(executionsData, existingExecutionsData []interface{}) []interface{} {
+	existingExecutionsByKey := map[string][]map[string]interface{}{}
+	for _, v := range existingExecutionsData {
+		if v == nil {
+			continue
+		}
+
+		existingExecutionData := v.(map[string]interface{})
+		key := authenticationFlowTreeExecutionKey(existingExecutionData)
+		existingExecutionsByKey[key] = append(existingExecutionsByKey[key], existingExecutionData)
+	}
+
+	matchedExecutionsData := make([]interface{}, 0, len(executionsData))
+	for _, v := range executionsData {
+		if v == nil {
+			continue
+		}
+
+		executionData := map[string]interface{}{}
+		for key, value := range v.(map[string]interface{}) {
+			executionData[key] = value
+		}
+
+		existingExecutionData := map[string]interface{}{}
+		key := authenticationFlowTreeExecutionKey(executionData)
+		if existingExecutions := existingExecutionsByKey[key]; len(existingExecutions) != 0 {
+			existingExecutionData = existingExecutions[0]
+			existingExecutionsByKey[key] = existingExecutions[1:]
+		}
+
+		executionData["id"] = stringOrEmpty(existingExecutionData["id"])
+
+		if configs, ok := executionData["config"].([]interface{}); ok && len(configs) != 0 && configs[0] != nil {
+			configData := copyAuthenticationFlowTreeBlock(configs[0])
+			configData["id"] = stringOrEmpty(authenticationFlowTreeBlockAttribute(existingExecutionData["config"], "id"))
+			executionData["config"] = []interface{}{configData}
+		}
+
+		if subFlows, ok := executionData["subflow"].([]interface{}); ok && len(subFlows) != 0 && subFlows[0] != nil {
+			subFlowData := copyAuthenticationFlowTreeBlock(subFlows[0])
+			subFlowData["id"] = stringOrEmpty(authenticationFlowTreeBlockAttribute(existingExecutionData["subflow"], "id"))
+
+			existingSubFlowExecutions, _ := authenticationFlowTreeBlockAttribute(existingExecutionData["subflow"], "execution").([]interface{})
+			if subFlowExecutions, ok := subFlowData["execution"].([]interface{}); ok {
+				subFlowData["execution"] = matchAuthenticationFlowTreeExecutionIds(subFlowExecutions, existingSubFlowExecutions)
+			}
+
+			executionData["subflow"] = []interface{}{subFlowData}
+		}
+
+		matchedExecutionsData = append(matchedExecutionsData, executionData)
+	}
+
+	return matchedExecutionsData
+}
+
+func copyAuthenticationFlowTreeBlock(blockData interface{}) map[string]interface{} {
+	copiedBlockData := map[string]interface{}{}
+	for key, value := range blockData.(map[string]interface{}) {
+		copiedBlockData[key] = value
+	}
+
+	return copiedBlockData
+}
+
+// authenticationFlowTreeBlockAttribute returns an attribute of a single nested block, such as the config or the subflow of an execution
+func authenticationFlowTreeBlockAttribute(blocksData interface{}, attribute string) interface{} {
+	blocks, ok := blocksData.([]interface{})
+	if !ok || len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+
+	return blocks[0].(map[string]interface{})[attribute]
+}
+
+func stringOrEmpty(value interface{}) string {
+	s, _ := value.(string)
+
+	return s
+}
+
+func mapFromDataToAuthenticationFlowTreeExecutions(executionsData []interface{}) ([]*keycloak.AuthenticationFlowTreeExecution, error) {
+	var executions []*keycloak.AuthenticationFlowTreeExecution
+
+	for _, v := range executionsData {
+		executionData := v.(map[string]interface{})
+
+		execution := &keycloak.AuthenticationFlowTreeExecution{
+			Authenticator: executionData["authenticator"].(string),
+			Requirement:   executionData["requirement"].(string),
+		}
+
+		if configs, ok := executionData["config"].([]interface{}); ok && len(configs) != 0 && configs[0] != nil {
+			configData := configs[0].(map[string]interface{})
+
+			config := map[string]string{}
+			for key, value := range configData["config"].(map[string]interface{}) {
+				config[key] = value.(string)
+			}
+
+			execution.Config = &keycloak.AuthenticationExecutionConfig{
+				Alias:  configData["alias"].(string),
+				Config: config,
+			}
+		}
+
+		if subFlows, ok := executionData["subflow"].([]interface{}); ok && len(subFlows) != 0 && subFlows[0] != nil {
+			subFlowData := subFlows[0].(map[string]interface{})
+
+			subFlowExecutions, err := mapFromDataToAuthenticationFlowTreeExecutions(subFlowData["execution"].([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+
+			execution.SubFlow = &keycloak.AuthenticationFlowTreeSubFlow{
+				Alias:       subFlowData["alias"].(string),
+				ProviderId:  subFlowData["provider_id"].(string),
+				Description: subFlowData["description"].(string),
+				Executions:  subFlowExecutions,
+			}
+		} else if execution.Authenticator == "" {
+			return nil, fmt.Errorf("execution: one of authenticator or subflow must be set")
+		}
+
+		executions = append(executions, execution)
+	}
+
+	return executions, nil
+}
+
+func mapFromAuthenticationFlowTreeExecutionsToData(executions []*keycloak.AuthenticationFlowTreeExecution) []interface{} {
+	var executionsData []interface{}
+
+	for _, execution := range executions {
+		executionData := map[string]interface{}{
+			"id":            execution.Id,
+			"authenticator": execution.Authenticator,
+			"requirement":   execution.Requirement,
+		}
+
+		if execution.Config != nil {
+			config := map[string]interface{}{}
+			for key, value := range execution.Config.Config {
+				config[key] = value
+			}
+
+			executionData["config"] = []interface{}{
+				map[string]interface{}{
+					"id":     execution.Config.Id,
+					"alias":  execution.Config.Alias,
+					"config": config,
+				},
+			}
+		}
+
+		if execution.SubFlow != nil {
+			executionData["subflow"] = []interface{}{
+				map[string]interface{}{
+					"id":          execution.SubFlow.Id,
+					"alias":       execution.SubFlow.Alias,
+					"provider_id": execution.SubFlow.ProviderId,
+					"description": execution.SubFlow.Description,
+					"execution":   mapFromAuthenticationFlowTreeExecutionsToData(execution.SubFlow.Executions),
+				},
+			}
+		}
+
+		executionsData = append(executionsData, executionData)
+	}
+
+	return executionsData
+}
+
+func resourceKeycloakAuthenticationFlowTreeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	executions, err := mapFromDataToAuthenticationFlowTreeExecutions(data.Get("execution").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	authenticationFlow := mapFromDataToAuthenticationFlow(data)
+
+	// the executions of a copied flow are kept when they match the declared ones, or when none is declared
+	if copyFrom := data.Get("copy_from").(string); copyFrom != "" {
+		err = keycloakClient.CopyAuthenticationFlow(ctx, copyFrom, authenticationFlow)
+	} else {
+		err = keycloakClient.NewAuthenticationFlow(ctx, authenticationFlow)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(authenticationFlow.Id)
+
+	if authenticationFlowTreeExecutionsDeclared(data.GetRawConfig()) {
+		err = keycloakClient.ReconcileAuthenticationFlowTree(ctx, authenticationFlow.RealmId, authenticationFlow.Alias, executions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKeycloakAuthenticationFlowTreeRead(ctx, data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowTreeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	authenticationFlow, err := keycloakClient.GetAuthenticationFlow(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	executions, err := keycloakClient.GetAuthenticationFlowTree(ctx, realmId, authenticationFlow.Alias)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromAuthenticationFlowToData(data, authenticationFlow)
+	data.Set("execution", mapFromAuthenticationFlowTreeExecutionsToData(executions))
+
+	return nil
+}
+
+func resourceKeycloakAuthenticationFlowTreeUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	executions, err := mapFromDataToAuthenticationFlowTreeExecutions(data.Get("execution").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	authenticationFlow := mapFromDataToAuthenticationFlow(data)
+
+	if data.HasChanges("alias", "description") {
+		err = keycloakClient.UpdateAuthenticationFlow(ctx, authenticationFlow)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if authenticationFlowTreeExecutionsDeclared(data.GetRawConfig()) {
+		err = keycloakClient.ReconcileAuthenticationFlowTree(ctx, authenticationFlow.RealmId, authenticationFlow.Alias, executions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKeycloakAuthenticationFlowTreeRead(ctx, data, meta)
+}
+
+func resourceKeycloakAuthenticationFlowTreeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return diag.FromErr(keycloakClient.DeleteAuthenticationFlow(ctx, realmId, id))
+}
+
+func resourceKeycloakAuthenticationFlowTreeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{authenticationFlowId}}")
+	}
+
+	_, err := keycloakClient.GetAuthenticationFlow(ctx, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", parts[0])
+	d.SetId(parts[1])
+
+	diagnostics := resourceKeycloakAuthenticationFlowTreeRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,513 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakAuthenticationFlowTree_basic(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_authentication_flow_tree.flow"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowTree_browser(flowAlias, "ALTERNATIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "execution.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "execution.0.authenticator", "auth-cookie"),
+					resource.TestCheckResourceAttr(resourceName, "execution.1.authenticator", "identity-provider-redirector"),
+					resource.TestCheckResourceAttr(resourceName, "execution.1.config.0.config.defaultProvider", "my-idp"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.subflow.0.alias", flowAlias+"-forms"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.subflow.0.execution.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.subflow.0.execution.1.subflow.0.execution.0.authenticator", "conditional-user-configured"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.subflow.0.execution.1.subflow.0.execution.1.authenticator", "auth-otp-form"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowTree_update(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_authentication_flow_tree.flow"
+
+	var cookieExecutionId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowTree_browser(flowAlias, "ALTERNATIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+					func(s *terraform.State) error {
+						cookieExecutionId = s.RootModule().Resources[resourceName].Primary.Attributes["execution.0.id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testKeycloakAuthenticationFlowTree_reordered(flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "execution.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "execution.0.authenticator", "identity-provider-redirector"),
+					resource.TestCheckNoResourceAttr(resourceName, "execution.0.config.0.alias"),
+					resource.TestCheckResourceAttr(resourceName, "execution.1.authenticator", "auth-spnego"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.authenticator", "auth-cookie"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.requirement", "REQUIRED"),
+					// moved executions are kept rather than recreated
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr(resourceName, "execution.2.id", cookieExecutionId)(s)
+					},
+				),
+			},
+			{
+				Config: testKeycloakAuthenticationFlowTree_browser(flowAlias, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "execution.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "execution.0.requirement", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.subflow.0.execution.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowTree_moveSubFlowBetweenLevels(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_authentication_flow_tree.flow"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowTree_browser(flowAlias, "ALTERNATIVE"),
+				Check:  testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+			},
+			{
+				// the otp subflow is moved out of the forms subflow, keeping its alias
+				Config: testKeycloakAuthenticationFlowTree_otpMovedUp(flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "execution.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "execution.1.subflow.0.alias", flowAlias+"-forms"),
+					resource.TestCheckResourceAttr(resourceName, "execution.1.subflow.0.execution.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.subflow.0.alias", flowAlias+"-otp"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.subflow.0.execution.#", "2"),
+				),
+			},
+			{
+				// and moved back into it
+				Config: testKeycloakAuthenticationFlowTree_browser(flowAlias, "ALTERNATIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "execution.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "execution.2.subflow.0.execution.1.subflow.0.alias", flowAlias+"-otp"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowTree_copyFrom(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_authentication_flow_tree.flow"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id  = data.keycloak_realm.realm.id
+	alias     = "%s"
+	copy_from = "browser"
+
+	execution {
+		authenticator = "auth-cookie"
+		requirement   = "ALTERNATIVE"
+	}
+	execution {
+		authenticator = "identity-provider-redirector"
+		requirement   = "ALTERNATIVE"
+	}
+}
+	`, testAccRealm.Realm, flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "execution.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "execution.0.authenticator", "auth-cookie"),
+					resource.TestCheckResourceAttr(resourceName, "execution.1.authenticator", "identity-provider-redirector"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowTree_copyFromWithoutExecutions(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_authentication_flow_tree.flow"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				// the copied executions are kept, and read into the state
+				Config: fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id  = data.keycloak_realm.realm.id
+	alias     = "%s"
+	copy_from = "browser"
+}
+	`, testAccRealm.Realm, flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "execution.0.authenticator", "auth-cookie"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowTree_insertExecution(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_authentication_flow_tree.flow"
+
+	var cookieExecutionId, redirectorExecutionId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakAuthenticationFlowTree_browser(flowAlias, "ALTERNATIVE"),
+				Check: func(s *terraform.State) error {
+					cookieExecutionId = s.RootModule().Resources[resourceName].Primary.Attributes["execution.0.id"]
+					redirectorExecutionId = s.RootModule().Resources[resourceName].Primary.Attributes["execution.1.id"]
+					return nil
+				},
+			},
+			{
+				// the executions after the inserted one keep their ids
+				Config: testKeycloakAuthenticationFlowTree_spnegoInserted(flowAlias),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "execution.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "execution.0.authenticator", "auth-spnego"),
+					func(s *terraform.State) error {
+						return resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(resourceName, "execution.1.id", cookieExecutionId),
+							resource.TestCheckResourceAttr(resourceName, "execution.2.id", redirectorExecutionId),
+						)(s)
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakAuthenticationFlowTree_executionWithoutAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	flowAlias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakAuthenticationFlowTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id = data.keycloak_realm.realm.id
+	alias    = "%s"
+
+	execution {
+		requirement = "REQUIRED"
+	}
+}
+	`, testAccRealm.Realm, flowAlias),
+				ExpectError: regexp.MustCompile("one of authenticator or subflow must be set"),
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak checks that the executions in the state are the executions
+// of the flow in Keycloak, in the same order
+func testAccCheckKeycloakAuthenticationFlowTreeMatchesKeycloak(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		alias := rs.Primary.Attributes["alias"]
+
+		executions, err := keycloakClient.ListAuthenticationExecutions(testCtx, realmId, alias)
+		if err != nil {
+			return err
+		}
+
+		var topLevelIds []string
+		for _, execution := range executions {
+			if execution.Level == 0 {
+				topLevelIds = append(topLevelIds, execution.Id)
+			}
+		}
+
+		if fmt.Sprint(len(topLevelIds)) != rs.Primary.Attributes["execution.#"] {
+			return fmt.Errorf("expected %s executions in flow %s, found %d", rs.Primary.Attributes["execution.#"], alias, len(topLevelIds))
+		}
+
+		for i, id := range topLevelIds {
+			if stateId := rs.Primary.Attributes[fmt.Sprintf("execution.%d.id", i)]; stateId != id {
+				return fmt.Errorf("expected execution %d of flow %s to be %s, found %s", i, alias, stateId, id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakAuthenticationFlowTreeDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_authentication_flow_tree" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			authenticationFlow, _ := keycloakClient.GetAuthenticationFlow(testCtx, realm, id)
+			if authenticationFlow != nil {
+				return fmt.Errorf("authentication flow with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakAuthenticationFlowTree_browser(alias, cookieRequirement string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "browser flow"
+
+	execution {
+		authenticator = "auth-cookie"
+		requirement   = "%s"
+	}
+
+	execution {
+		authenticator = "identity-provider-redirector"
+		requirement   = "ALTERNATIVE"
+
+		config {
+			alias = "%s-idp-redirector"
+			config = {
+				defaultProvider = "my-idp"
+			}
+		}
+	}
+
+	execution {
+		requirement = "ALTERNATIVE"
+
+		subflow {
+			alias = "%s-forms"
+
+			execution {
+				authenticator = "auth-username-password-form"
+				requirement   = "REQUIRED"
+			}
+
+			execution {
+				requirement = "CONDITIONAL"
+
+				subflow {
+					alias = "%s-otp"
+
+					execution {
+						authenticator = "conditional-user-configured"
+						requirement   = "REQUIRED"
+					}
+
+					execution {
+						authenticator = "auth-otp-form"
+						requirement   = "REQUIRED"
+					}
+				}
+			}
+		}
+	}
+}
+	`, testAccRealm.Realm, alias, cookieRequirement, alias, alias, alias)
+}
+
+func testKeycloakAuthenticationFlowTree_reordered(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "browser flow"
+
+	execution {
+		authenticator = "identity-provider-redirector"
+		requirement   = "ALTERNATIVE"
+	}
+
+	execution {
+		authenticator = "auth-spnego"
+		requirement   = "DISABLED"
+	}
+
+	execution {
+		authenticator = "auth-cookie"
+		requirement   = "REQUIRED"
+	}
+}
+	`, testAccRealm.Realm, alias)
+}
+
+func testKeycloakAuthenticationFlowTree_spnegoInserted(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "browser flow"
+
+	execution {
+		authenticator = "auth-spnego"
+		requirement   = "DISABLED"
+	}
+
+	execution {
+		authenticator = "auth-cookie"
+		requirement   = "ALTERNATIVE"
+	}
+
+	execution {
+		authenticator = "identity-provider-redirector"
+		requirement   = "ALTERNATIVE"
+	}
+}
+	`, testAccRealm.Realm, alias)
+}
+
+func testKeycloakAuthenticationFlowTree_otpMovedUp(alias string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_authentication_flow_tree" "flow" {
+	realm_id    = data.keycloak_realm.realm.id
+	alias       = "%s"
+	description = "browser flow"
+
+	execution {
+		authenticator = "auth-cookie"
+		requirement   = "ALTERNATIVE"
+	}
+
+	execution {
+		requirement = "ALTERNATIVE"
+
+		subflow {
+			alias = "%s-forms"
+
+			execution {
+				authenticator = "auth-username-password-form"
+				requirement   = "REQUIRED"
+			}
+		}
+	}
+
+	execution {
+		requirement = "ALTERNATIVE"
+
+		subflow {
+			alias = "%s-otp"
+
+			execution {
+				authenticator = "conditional-user-configured"
+				requirement   = "REQUIRED"
+			}
+
+			execution {
+				authenticator = "auth-otp-form"
+				requirement   = "REQUIRED"
+			}
+		}
+	}
+}
+	`, testAccRealm.Realm, alias, alias, alias)
+}
@@ -20,6 +20,7 @@ func resourceKeycloakAuthenticationSubFlow() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakAuthenticationSubFlowImport,
 		},
+		CustomizeDiff: validateAuthenticationExecutionPriority,
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -60,8 +61,10 @@ func resourceKeycloakAuthenticationSubFlow() *schema.Resource {
 				Default:      "DISABLED",
 			},
 			"priority": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Executions with lower priorities run first",
 			},
 		},
 	}
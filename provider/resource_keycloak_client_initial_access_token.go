@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// resourceKeycloakClientInitialAccessToken cannot be imported, as Keycloak only returns the token when it is created.
+// It is created again once it has expired or has been used up, as Keycloak then removes it.
+func resourceKeycloakClientInitialAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakClientInitialAccessTokenCreate,
+		ReadContext:   resourceKeycloakClientInitialAccessTokenRead,
+		DeleteContext: resourceKeycloakClientInitialAccessTokenDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of seconds the token is valid for, 0 for a token which never expires.",
+			},
+			"client_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of clients which can be registered with the token.",
+			},
+			"remaining_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"timestamp": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the token was created at, in seconds since the epoch.",
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceKeycloakClientInitialAccessTokenCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	token := &keycloak.ClientInitialAccessToken{
+		RealmId:    data.Get("realm_id").(string),
+		Expiration: data.Get("expiration").(int),
+		Count:      data.Get("client_count").(int),
+	}
+
+	err := keycloakClient.NewClientInitialAccessToken(ctx, token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(token.Id)
+	data.Set("token", token.Token)
+
+	return resourceKeycloakClientInitialAccessTokenRead(ctx, data, meta)
+}
+
+// resourceKeycloakClientInitialAccessTokenRead keeps the token of the state, as Keycloak does not return it
+func resourceKeycloakClientInitialAccessTokenRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	token, err := keycloakClient.GetClientInitialAccessToken(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("expiration", token.Expiration)
+	data.Set("client_count", token.Count)
+	data.Set("remaining_count", token.RemainingCount)
+	data.Set("timestamp", token.Timestamp)
+
+	return nil
+}
+
+func resourceKeycloakClientInitialAccessTokenDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.DeleteClientInitialAccessToken(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakClientRegistrationPolicyProviders = []string{
+	keycloak.ClientRegistrationPolicyTrustedHosts,
+	keycloak.ClientRegistrationPolicyAllowedClientScopes,
+	keycloak.ClientRegistrationPolicyAllowedProtocolMappers,
+	keycloak.ClientRegistrationPolicyMaxClients,
+	keycloak.ClientRegistrationPolicyConsentRequired,
+	keycloak.ClientRegistrationPolicyFullScopeDisabled,
+	keycloak.ClientRegistrationPolicyClientDisabled,
+}
+
+// the attributes which only apply to a given provider of client registration policy
+var keycloakClientRegistrationPolicyProviderAttributes = map[string]string{
+	"trusted_hosts":                 keycloak.ClientRegistrationPolicyTrustedHosts,
+	"allowed_client_scopes":         keycloak.ClientRegistrationPolicyAllowedClientScopes,
+	"allowed_protocol_mapper_types": keycloak.ClientRegistrationPolicyAllowedProtocolMappers,
+}
+
+func resourceKeycloakClientRegistrationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakClientRegistrationPolicyCreate,
+		ReadContext:   resourceKeycloakClientRegistrationPolicyRead,
+		UpdateContext: resourceKeycloakClientRegistrationPolicyUpdate,
+		DeleteContext: resourceKeycloakClientRegistrationPolicyDelete,
+		// This resource can be imported using {{realm}}/{{policy_id}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakClientRegistrationPolicyImport,
+		},
+		CustomizeDiff: resourceKeycloakClientRegistrationPolicyValidateProviderAttributes,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sub_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{keycloak.ClientRegistrationPolicyAnonymous, keycloak.ClientRegistrationPolicyAuthenticated}, false),
+				Description:  "anonymous applies the policy to the registrations without token, authenticated to the registrations with an initial access token or a bearer token.",
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(keycloakClientRegistrationPolicyProviders, false),
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"trusted_hosts": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The hosts or domains trusted to register clients, for the trusted-hosts provider.",
+			},
+			"host_sending_registration_request_must_match": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, the registration requests must come from a trusted host, for the trusted-hosts provider.",
+			},
+			"client_uris_must_match": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, the URIs of the registered clients must be on a trusted host, for the trusted-hosts provider.",
+			},
+			"allowed_client_scopes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The client scopes allowed on the registered clients, for the allowed-client-templates provider.",
+			},
+			"allow_default_scopes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, the default client scopes of the realm are allowed, for the allowed-client-templates provider.",
+			},
+			"allowed_protocol_mapper_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The protocol mapper types allowed on the registered clients, for the allowed-protocol-mappers provider.",
+			},
+			"max_clients": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      200,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of clients in the realm after which registrations are refused, for the max-clients provider.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakClientRegistrationPolicyValidateProviderAttributes(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	providerId := diff.Get("provider_id").(string)
+
+	for attribute, attributeProviderId := range keycloakClientRegistrationPolicyProviderAttributes {
+		if providerId != attributeProviderId && diff.Get(attribute).(*schema.Set).Len() != 0 {
+			return fmt.Errorf("validation error: %s only applies to the %s provider", attribute, attributeProviderId)
+		}
+	}
+
+	return nil
+}
+
+func getClientRegistrationPolicyFromData(data *schema.ResourceData, realmInternalId string) *keycloak.ClientRegistrationPolicy {
+	parentId := data.Get("parent_id").(string)
+	if parentId == "" {
+		parentId = realmInternalId
+	}
+
+	return &keycloak.ClientRegistrationPolicy{
+		Id:         data.Id(),
+		RealmId:    data.Get("realm_id").(string),
+		ParentId:   parentId,
+		Name:       data.Get("name").(string),
+		ProviderId: data.Get("provider_id").(string),
+		SubType:    data.Get("sub_type").(string),
+
+		TrustedHosts:                            interfaceSliceToStringSlice(data.Get("trusted_hosts").(*schema.Set).List()),
+		HostSendingRegistrationRequestMustMatch: data.Get("host_sending_registration_request_must_match").(bool),
+		ClientUrisMustMatch:                     data.Get("client_uris_must_match").(bool),
+		AllowedClientScopes:                     interfaceSliceToStringSlice(data.Get("allowed_client_scopes").(*schema.Set).List()),
+		AllowDefaultScopes:                      data.Get("allow_default_scopes").(bool),
+		AllowedProtocolMapperTypes:              interfaceSliceToStringSlice(data.Get("allowed_protocol_mapper_types").(*schema.Set).List()),
+		MaxClients:                              data.Get("max_clients").(int),
+	}
+}
+
+func setClientRegistrationPolicyData(data *schema.ResourceData, policy *keycloak.ClientRegistrationPolicy) {
+	data.SetId(policy.Id)
+
+	data.Set("realm_id", policy.RealmId)
+	data.Set("parent_id", policy.ParentId)
+	data.Set("name", policy.Name)
+	data.Set("provider_id", policy.ProviderId)
+	data.Set("sub_type", policy.SubType)
+
+	// the config of the other providers is left to its defaults
+	switch policy.ProviderId {
+	case keycloak.ClientRegistrationPolicyTrustedHosts:
+		data.Set("trusted_hosts", policy.TrustedHosts)
+		data.Set("host_sending_registration_request_must_match", policy.HostSendingRegistrationRequestMustMatch)
+		data.Set("client_uris_must_match", policy.ClientUrisMustMatch)
+	case keycloak.ClientRegistrationPolicyAllowedClientScopes:
+		data.Set("allowed_client_scopes", policy.AllowedClientScopes)
+		data.Set("allow_default_scopes", policy.AllowDefaultScopes)
+	case keycloak.ClientRegistrationPolicyAllowedProtocolMappers:
+		data.Set("allowed_protocol_mapper_types", policy.AllowedProtocolMapperTypes)
+	case keycloak.ClientRegistrationPolicyMaxClients:
+		data.Set("max_clients", policy.MaxClients)
+	}
+}
+
+func resourceKeycloakClientRegistrationPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := getClientRegistrationPolicyFromData(data, realm.Id)
+
+	err = keycloakClient.NewClientRegistrationPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setClientRegistrationPolicyData(data, policy)
+
+	return resourceKeycloakClientRegistrationPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakClientRegistrationPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy, err := keycloakClient.GetClientRegistrationPolicy(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setClientRegistrationPolicyData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakClientRegistrationPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getClientRegistrationPolicyFromData(data, "")
+
+	err := keycloakClient.UpdateClientRegistrationPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakClientRegistrationPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakClientRegistrationPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return diag.FromErr(keycloakClient.DeleteClientRegistrationPolicy(ctx, data.Get("realm_id").(string), data.Id()))
+}
+
+func resourceKeycloakClientRegistrationPolicyImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(data.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{clientRegistrationPolicyId}}")
+	}
+
+	policy, err := keycloakClient.GetClientRegistrationPolicy(ctx, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	setClientRegistrationPolicyData(data, policy)
+
+	return []*schema.ResourceData{data}, nil
+}
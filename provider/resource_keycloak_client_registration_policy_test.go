@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakClientRegistrationPolicy_trustedHosts(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationPolicy_trustedHosts(policyName, "example.com", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyExists("keycloak_client_registration_policy.trusted_hosts"),
+					resource.TestCheckResourceAttr("keycloak_client_registration_policy.trusted_hosts", "trusted_hosts.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_client_registration_policy.trusted_hosts", "client_uris_must_match", "true"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_client_registration_policy.trusted_hosts",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getClientRegistrationPolicyImportId("keycloak_client_registration_policy.trusted_hosts"),
+			},
+			{
+				ResourceName:      "keycloak_client_registration_policy.trusted_hosts",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/anonymous/%s", testAccRealm.Realm, policyName),
+			},
+			{
+				Config: testKeycloakClientRegistrationPolicy_trustedHosts(policyName, "example.org", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientRegistrationPolicyHasTrustedHost("keycloak_client_registration_policy.trusted_hosts", "example.org"),
+					resource.TestCheckResourceAttr("keycloak_client_registration_policy.trusted_hosts", "client_uris_must_match", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientRegistrationPolicy_maxClients(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakClientRegistrationPolicy_maxClients(policyName, 10),
+				Check:  resource.TestCheckResourceAttr("keycloak_client_registration_policy.max_clients", "max_clients", "10"),
+			},
+			{
+				Config: testKeycloakClientRegistrationPolicy_maxClients(policyName, 20),
+				Check:  resource.TestCheckResourceAttr("keycloak_client_registration_policy.max_clients", "max_clients", "20"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakClientRegistrationPolicy_providerAttributeValidation(t *testing.T) {
+	t.Parallel()
+
+	policyName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientRegistrationPolicyDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakClientRegistrationPolicy_trustedHostsOnConsentRequired(policyName),
+				ExpectError: regexp.MustCompile("validation error: trusted_hosts only applies to the trusted-hosts provider"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakClientRegistrationPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getClientRegistrationPolicyFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakClientRegistrationPolicyHasTrustedHost(resourceName, trustedHost string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		policy, err := getClientRegistrationPolicyFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if len(policy.TrustedHosts) != 1 || policy.TrustedHosts[0] != trustedHost {
+			return fmt.Errorf("expected client registration policy %s to trust %s only, got %v", policy.Name, trustedHost, policy.TrustedHosts)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakClientRegistrationPolicyDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_client_registration_policy" {
+				continue
+			}
+
+			policy, _ := keycloakClient.GetClientRegistrationPolicy(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if policy != nil {
+				return fmt.Errorf("client registration policy with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getClientRegistrationPolicyFromState(s *terraform.State, resourceName string) (*keycloak.ClientRegistrationPolicy, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	policy, err := keycloakClient.GetClientRegistrationPolicy(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client registration policy with id %s: %s", rs.Primary.ID, err)
+	}
+
+	return policy, nil
+}
+
+func getClientRegistrationPolicyImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.ID), nil
+	}
+}
+
+func testKeycloakClientRegistrationPolicy_trustedHosts(name, trustedHost string, clientUrisMustMatch bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_policy" "trusted_hosts" {
+	realm_id    = data.keycloak_realm.realm.id
+	name        = "%s"
+	sub_type    = "anonymous"
+	provider_id = "trusted-hosts"
+
+	trusted_hosts          = ["%s"]
+	client_uris_must_match = %t
+}
+	`, testAccRealm.Realm, name, trustedHost, clientUrisMustMatch)
+}
+
+func testKeycloakClientRegistrationPolicy_maxClients(name string, maxClients int) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_policy" "max_clients" {
+	realm_id    = data.keycloak_realm.realm.id
+	name        = "%s"
+	sub_type    = "authenticated"
+	provider_id = "max-clients"
+
+	max_clients = %d
+}
+	`, testAccRealm.Realm, name, maxClients)
+}
+
+func testKeycloakClientRegistrationPolicy_trustedHostsOnConsentRequired(name string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_client_registration_policy" "consent_required" {
+	realm_id    = data.keycloak_realm.realm.id
+	name        = "%s"
+	sub_type    = "anonymous"
+	provider_id = "consent-required"
+
+	trusted_hosts = ["example.com"]
+}
+	`, testAccRealm.Realm, name)
+}
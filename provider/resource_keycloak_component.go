@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// the admin API masks the values of secret config properties
+const componentSecretConfigValue = "**********"
+
+func resourceKeycloakComponent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakComponentCreate,
+		ReadContext:   resourceKeycloakComponentRead,
+		UpdateContext: resourceKeycloakComponentUpdate,
+		DeleteContext: resourceKeycloakComponentDelete,
+		// This resource can be imported using {{realm}}/{{component_id}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakComponentImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of the component, such as org.keycloak.keys.KeyProvider.",
+			},
+			"provider_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the provider implementing the component, such as rsa-generated.",
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The id of the parent of the component. Defaults to the internal id of the realm.",
+			},
+			"sub_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The sub type of the component, such as anonymous or authenticated for the client registration policies.",
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The config of the component. Multiple values are separated by ##.",
+			},
+		},
+	}
+}
+
+func getComponentFromData(data *schema.ResourceData, realmInternalId string) *keycloak.Component {
+	config := map[string][]string{}
+	for key, value := range data.Get("config").(map[string]interface{}) {
+		config[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	parentId := data.Get("parent_id").(string)
+	if parentId == "" {
+		parentId = realmInternalId
+	}
+
+	return &keycloak.Component{
+		Id:           data.Id(),
+		RealmId:      data.Get("realm_id").(string),
+		Name:         data.Get("name").(string),
+		ProviderType: data.Get("provider_type").(string),
+		ProviderId:   data.Get("provider_id").(string),
+		ParentId:     parentId,
+		SubType:      data.Get("sub_type").(string),
+		Config:       config,
+	}
+}
+
+// getComponentConfigData converts the config of a component into the config attribute. The keys which are not listed
+// in keys are left out, unless keys is nil. The values of the secret properties are taken from configured.
+func getComponentConfigData(component *keycloak.Component, keys map[string]interface{}, configured map[string]interface{}) map[string]string {
+	config := map[string]string{}
+
+	for key, values := range component.Config {
+		if _, ok := keys[key]; keys != nil && !ok {
+			continue
+		}
+
+		if len(values) == 1 && values[0] == componentSecretConfigValue {
+			if value, ok := configured[key]; ok {
+				config[key] = value.(string)
+			}
+			continue
+		}
+
+		config[key] = strings.Join(values, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	return config
+}
+
+func setComponentData(data *schema.ResourceData, component *keycloak.Component) {
+	configured := data.Get("config").(map[string]interface{})
+
+	data.SetId(component.Id)
+
+	data.Set("realm_id", component.RealmId)
+	data.Set("name", component.Name)
+	data.Set("provider_type", component.ProviderType)
+	data.Set("provider_id", component.ProviderId)
+	data.Set("parent_id", component.ParentId)
+	data.Set("sub_type", component.SubType)
+	// the config keys which are not managed by this resource are ignored, as Keycloak adds default values
+	data.Set("config", getComponentConfigData(component, configured, configured))
+}
+
+// validateComponent checks the component against the properties of its component type. The config keys which are not
+// properties of the component type are reported as warnings, as some are common to all the providers of a type.
+func validateComponent(ctx context.Context, keycloakClient *keycloak.KeycloakClient, component *keycloak.Component) diag.Diagnostics {
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	componentType, err := serverInfo.GetComponentType(component.ProviderType, component.ProviderId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	properties := map[string]keycloak.ConfigPropertyType{}
+	for _, property := range componentType.Properties {
+		properties[property.Name] = property
+
+		if _, ok := component.Config[property.Name]; !ok && property.Required && property.DefaultValue == nil {
+			diags = append(diags, diag.Errorf("config property %s is required by provider %s", property.Name, component.ProviderId)...)
+		}
+	}
+
+	for key, values := range component.Config {
+		property, ok := properties[key]
+		if !ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unknown config key",
+				Detail:   fmt.Sprintf("config key %s is not a property of provider %s", key, component.ProviderId),
+			})
+			continue
+		}
+
+		for _, value := range values {
+			switch {
+			case property.Type == "boolean" && value != "true" && value != "false":
+				diags = append(diags, diag.Errorf("config property %s of provider %s must be true or false, got %s", key, component.ProviderId, value)...)
+			case (property.Type == "List" || property.Type == "MultivaluedList") && len(property.Options) != 0 && !slices.Contains(property.Options, value):
+				diags = append(diags, diag.Errorf("config property %s of provider %s must be one of %s, got %s", key, component.ProviderId, strings.Join(property.Options, ", "), value)...)
+			}
+		}
+	}
+
+	return diags
+}
+
+func resourceKeycloakComponentCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	component := getComponentFromData(data, realm.Id)
+
+	diags := validateComponent(ctx, keycloakClient, component)
+	if diags.HasError() {
+		return diags
+	}
+
+	err = keycloakClient.NewComponent(ctx, component)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	data.SetId(component.Id)
+
+	return append(diags, resourceKeycloakComponentRead(ctx, data, meta)...)
+}
+
+func resourceKeycloakComponentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	component, err := keycloakClient.GetComponent(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setComponentData(data, component)
+
+	return nil
+}
+
+func resourceKeycloakComponentUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	component := getComponentFromData(data, realm.Id)
+
+	diags := validateComponent(ctx, keycloakClient, component)
+	if diags.HasError() {
+		return diags
+	}
+
+	err = keycloakClient.UpdateComponent(ctx, component)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceKeycloakComponentRead(ctx, data, meta)...)
+}
+
+func resourceKeycloakComponentDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	return diag.FromErr(keycloakClient.DeleteComponent(ctx, data.Get("realm_id").(string), data.Id()))
+}
+
+func resourceKeycloakComponentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{componentId}}")
+	}
+
+	component, err := keycloakClient.GetComponent(ctx, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	// all the config keys are managed once imported, except the secret ones which cannot be read
+	d.Set("realm_id", parts[0])
+	d.Set("config", getComponentConfigData(component, nil, nil))
+	d.SetId(parts[1])
+
+	diagnostics := resourceKeycloakComponentRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakComponent_basic(t *testing.T) {
+	t.Parallel()
+
+	componentName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakComponentDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakComponent_rsaGenerated(componentName, "2048"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakComponentExists("keycloak_component.rsa"),
+					resource.TestCheckResourceAttr("keycloak_component.rsa", "config.keySize", "2048"),
+					resource.TestCheckResourceAttrPair("keycloak_component.rsa", "parent_id", "data.keycloak_realm.realm", "internal_id"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_component.rsa",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getComponentImportId("keycloak_component.rsa"),
+				// all the config keys are managed once imported, including the defaults added by Keycloak
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+			{
+				ResourceName:      "keycloak_component.rsa",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/org.keycloak.keys.KeyProvider/%s", testAccRealm.Realm, componentName),
+				// all the config keys are managed once imported, including the defaults added by Keycloak
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+			{
+				Config: testKeycloakComponent_rsaGenerated(componentName, "4096"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakComponentExists("keycloak_component.rsa"),
+					resource.TestCheckResourceAttr("keycloak_component.rsa", "config.keySize", "4096"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakComponent_validation(t *testing.T) {
+	t.Parallel()
+
+	componentName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakComponentDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakComponent_rsaGenerated(componentName, "1000"),
+				ExpectError: regexp.MustCompile("config property keySize of provider rsa-generated must be one of .+, got 1000"),
+			},
+			{
+				Config:      testKeycloakComponent_unknownProvider(componentName),
+				ExpectError: regexp.MustCompile("provider does-not-exist of type org.keycloak.keys.KeyProvider is not installed"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakComponentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getComponentFromState(s, resourceName)
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakComponentDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_component" {
+				continue
+			}
+
+			component, _ := keycloakClient.GetComponent(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if component != nil {
+				return fmt.Errorf("component with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getComponentFromState(s *terraform.State, resourceName string) (*keycloak.Component, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	component, err := keycloakClient.GetComponent(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting component with id %s: %s", rs.Primary.ID, err)
+	}
+
+	return component, nil
+}
+
+func getComponentImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.ID), nil
+	}
+}
+
+func testKeycloakComponent_rsaGenerated(name, keySize string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_component" "rsa" {
+	realm_id      = data.keycloak_realm.realm.id
+	name          = "%s"
+	provider_type = "org.keycloak.keys.KeyProvider"
+	provider_id   = "rsa-generated"
+
+	config = {
+		priority  = "100"
+		keySize   = "%s"
+		algorithm = "RS256"
+	}
+}
+	`, testAccRealm.Realm, name, keySize)
+}
+
+func testKeycloakComponent_unknownProvider(name string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_component" "rsa" {
+	realm_id      = data.keycloak_realm.realm.id
+	name          = "%s"
+	provider_type = "org.keycloak.keys.KeyProvider"
+	provider_id   = "does-not-exist"
+}
+	`, testAccRealm.Realm, name)
+}
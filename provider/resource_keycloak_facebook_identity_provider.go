@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakFacebookIdentityProvider() *schema.Resource {
+	return resourceKeycloakSocialIdentityProvider(&socialIdentityProvider{
+		providerId: "facebook",
+		schema: map[string]*schema.Schema{
+			"fetched_fields": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma separated list of the profile fields fetched from Facebook, in addition to the default ones (id, name, email, first_name and last_name).",
+			},
+		},
+		configKeys: map[string]string{
+			"fetched_fields": "fetchedFields",
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKeycloakGithubIdentityProvider() *schema.Resource {
+	return resourceKeycloakSocialIdentityProvider(&socialIdentityProvider{
+		providerId: "github",
+		schema: map[string]*schema.Schema{
+			"base_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "Override the default Base URL for this identity provider, for GitHub Enterprise. Defaults to https://github.com.",
+			},
+			"api_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "Override the default API URL for this identity provider, for GitHub Enterprise. Defaults to https://api.github.com.",
+			},
+		},
+		configKeys: map[string]string{
+			"base_url": "baseUrl",
+			"api_url":  "apiUrl",
+		},
+	})
+}
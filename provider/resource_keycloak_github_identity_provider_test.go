@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakGithubIdentityProvider_enterpriseUrls(t *testing.T) {
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_github_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_github_identity_provider", alias, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderExists("keycloak_github_identity_provider.social"),
+					resource.TestCheckResourceAttr("keycloak_github_identity_provider.social", "provider_id", "github"),
+				),
+			},
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_github_identity_provider", alias, `
+	base_url = "https://github.example.com"
+	api_url  = "https://github.example.com/api/v3"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_github_identity_provider.social", "baseUrl", "https://github.example.com"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_github_identity_provider.social", "apiUrl", "https://github.example.com/api/v3"),
+				),
+			},
+			{
+				ResourceName:            "keycloak_github_identity_provider.social",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           testAccRealm.Realm + "/" + alias,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+		},
+	})
+}
+
+// ensure that the options of the provider cannot be set through extra_config
+func TestAccKeycloakGithubIdentityProvider_extraConfigInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_github_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_github_identity_provider", acctest.RandomWithPrefix("tf-acc"), `
+	extra_config = {
+		baseUrl = "https://github.example.com"
+	}
+				`),
+				ExpectError: regexp.MustCompile(`extra_config key "baseUrl" is not allowed, use the base_url attribute instead`),
+			},
+		},
+	})
+}
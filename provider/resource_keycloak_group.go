@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"strings"
 
+	"dario.cat/mergo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
@@ -44,6 +45,16 @@ func resourceKeycloakGroup() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"import": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"keep_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -87,9 +98,34 @@ func resourceKeycloakGroupCreate(ctx context.Context, data *schema.ResourceData,
 
 	group := mapFromDataToGroup(data)
 
-	err := keycloakClient.NewGroup(ctx, group)
-	if err != nil {
-		return diag.FromErr(err)
+	if !data.Get("import").(bool) {
+		err := keycloakClient.NewGroup(ctx, group)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		path := "/" + group.Name
+		if group.ParentId != "" {
+			parentGroup, err := keycloakClient.GetGroup(ctx, group.RealmId, group.ParentId)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			path = parentGroup.Path + path
+		}
+
+		existingGroup, err := keycloakClient.GetGroupByPath(ctx, group.RealmId, path)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = mergo.Merge(group, existingGroup); err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = keycloakClient.UpdateGroup(ctx, group)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	mapFromGroupToData(data, group)
@@ -110,6 +146,10 @@ func resourceKeycloakGroupRead(ctx context.Context, data *schema.ResourceData, m
 
 	mapFromGroupToData(data, group)
 
+	if _, ok := data.GetOk("import"); !ok {
+		data.Set("import", false)
+	}
+
 	return nil
 }
 
@@ -129,6 +169,10 @@ func resourceKeycloakGroupUpdate(ctx context.Context, data *schema.ResourceData,
 }
 
 func resourceKeycloakGroupDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if keepOnDestroy(data.GetRawState()) {
+		return nil
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
@@ -15,6 +16,9 @@ func resourceKeycloakGroupMemberships() *schema.Resource {
 		ReadContext:   resourceKeycloakGroupMembershipsRead,
 		DeleteContext: resourceKeycloakGroupMembershipsDelete,
 		UpdateContext: resourceKeycloakGroupMembershipsUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGroupMembershipsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -134,3 +138,16 @@ func resourceKeycloakGroupMembershipsDelete(ctx context.Context, data *schema.Re
 func groupMembershipsId(realmId, groupId string) string {
 	return fmt.Sprintf("%s/group-memberships/%s", realmId, groupId)
 }
+
+func resourceKeycloakGroupMembershipsImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{groupId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("group_id", parts[1])
+	d.SetId(groupMembershipsId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
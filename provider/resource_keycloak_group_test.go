@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -51,6 +52,154 @@ func runTestBasicGroup(t *testing.T, groupName, attributeName, attributeValue st
 	})
 }
 
+func TestAccKeycloakGroup_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroup_deletionProtection(groupName, "parent_a", true),
+				Check:  testAccCheckKeycloakGroupExists("keycloak_group.group"),
+			},
+			{
+				// moving the group to another parent replaces it, which is rejected when planned
+				Config:      testKeycloakGroup_deletionProtection(groupName, "parent_b", true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("keycloak_group .+ is protected against deletion, and changing \\[parent_id\\] replaces it"),
+			},
+			{
+				Config: testKeycloakGroup_deletionProtection(groupName, "parent_a", false),
+				Check:  testAccCheckKeycloakGroupExists("keycloak_group.group"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroup_importFromIdentity(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	attributeName := acctest.RandomWithPrefix("tf-acc")
+	attributeValue := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroup_basic(groupName, attributeName, attributeValue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakGroupExists("keycloak_group.group"),
+					testAccCheckResourceImportableFromIdentity("keycloak_group.group", map[string]string{
+						"realm_id": testAccRealm.Realm,
+						"path":     "/" + groupName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroup_importByPath(t *testing.T) {
+	t.Parallel()
+
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	attributeName := acctest.RandomWithPrefix("tf-acc")
+	attributeValue := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroup_basic(groupName, attributeName, attributeValue),
+				Check:  testAccCheckKeycloakGroupExists("keycloak_group.group"),
+			},
+			{
+				ResourceName:      "keycloak_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testAccRealm.Realm + "//" + groupName,
+			},
+			{
+				ResourceName:  "keycloak_group.group",
+				ImportState:   true,
+				ImportStateId: testAccRealm.Realm + "//" + groupName + "-missing",
+				ExpectError:   regexp.MustCompile("Supported import formats"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroup_adopt(t *testing.T) {
+	t.Parallel()
+
+	existingGroup := &keycloak.Group{
+		RealmId: testAccRealm.Realm,
+		Name:    acctest.RandomWithPrefix("tf-acc"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupNotDestroyed(),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					err := keycloakClient.NewGroup(testCtx, existingGroup)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					t.Cleanup(func() {
+						keycloakClient.DeleteGroup(testCtx, existingGroup.RealmId, existingGroup.Id)
+					})
+				},
+				Config: testKeycloakGroup_adopt(existingGroup.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("keycloak_group.group", "id", &existingGroup.Id),
+					resource.TestCheckResourceAttr("keycloak_group.group", "name", existingGroup.Name),
+					resource.TestCheckResourceAttr("keycloak_group.group", "path", "/"+existingGroup.Name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroup_adoptAndDeleteOnDestroy(t *testing.T) {
+	t.Parallel()
+
+	existingGroup := &keycloak.Group{
+		RealmId: testAccRealm.Realm,
+		Name:    acctest.RandomWithPrefix("tf-acc"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					err := keycloakClient.NewGroup(testCtx, existingGroup)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakGroup_adoptAndDeleteOnDestroy(existingGroup.Name),
+				Check:  resource.TestCheckResourceAttrPtr("keycloak_group.group", "id", &existingGroup.Id),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakGroup_createAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 
@@ -336,6 +485,27 @@ func testAccCheckKeycloakGroupDestroy() resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckKeycloakGroupNotDestroyed checks that adopted groups are left in place
+func testAccCheckKeycloakGroupNotDestroyed() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_group" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			group, _ := keycloakClient.GetGroup(testCtx, realm, id)
+			if group == nil {
+				return fmt.Errorf("group with id %s does not exist", id)
+			}
+		}
+
+		return nil
+	}
+}
+
 func getGroupFromState(s *terraform.State, resourceName string) (*keycloak.Group, error) {
 	rs, ok := s.RootModule().Resources[resourceName]
 	if !ok {
@@ -353,6 +523,31 @@ func getGroupFromState(s *terraform.State, resourceName string) (*keycloak.Group
 	return group, nil
 }
 
+func testKeycloakGroup_deletionProtection(group, parentGroup string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "parent_a" {
+	name     = "%s-a"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_group" "parent_b" {
+	name     = "%s-b"
+	realm_id = data.keycloak_realm.realm.id
+}
+
+resource "keycloak_group" "group" {
+	name                = "%s"
+	realm_id            = data.keycloak_realm.realm.id
+	parent_id           = keycloak_group.%s.id
+	deletion_protection = %t
+}
+	`, testAccRealm.Realm, group, group, group, parentGroup, deletionProtection)
+}
+
 func testKeycloakGroup_basic(group string, attributeName string, attributeValue string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -440,3 +635,32 @@ resource "keycloak_group" "group" {
 }
 	`, testAccRealm.Realm, strings.ReplaceAll(group.Name, "\\", "\\\\"))
 }
+
+func testKeycloakGroup_adopt(group string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+	import   = true
+}
+	`, testAccRealm.Realm, group)
+}
+
+func testKeycloakGroup_adoptAndDeleteOnDestroy(group string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id        = data.keycloak_realm.realm.id
+	name            = "%s"
+	import          = true
+	keep_on_destroy = false
+}
+	`, testAccRealm.Realm, group)
+}
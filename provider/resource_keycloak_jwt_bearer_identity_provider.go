@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+)
+
+// jwt bearer identity providers are OpenID Connect identity providers that only issue client assertions, such as
+// SPIFFE JWT-SVIDs. The client assertion settings are kept in the extra config, like the keycloak_oidc_identity_provider
+// resource would do.
+const (
+	supportsClientAssertionsConfigKey     = "supportsClientAssertions"
+	supportsClientAssertionReuseConfigKey = "supportsClientAssertionReuse"
+)
+
+func resourceKeycloakJwtBearerIdentityProvider() *schema.Resource {
+	jwtBearerSchema := map[string]*schema.Schema{
+		"provider_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "oidc",
+			ForceNew:    true,
+			Description: "provider id, is always oidc, unless you have a extended custom implementation",
+		},
+		"issuer": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The issuer of the client assertions, matched against their iss claim.",
+		},
+		"jwks_url": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "The URL of the keys the client assertions are signed with.",
+		},
+		"allow_client_assertion_reuse": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow a client assertion to be used more than once until it expires, as long-lived workload tokens are.",
+		},
+		"hide_on_login_page": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Hide On Login Page. Users do not log in with this identity provider, it only authenticates clients.",
+		},
+	}
+	jwtBearerResource := resourceKeycloakIdentityProvider()
+	jwtBearerResource.Schema = mergeSchemas(jwtBearerResource.Schema, jwtBearerSchema)
+	jwtBearerResource.Schema["extra_config"].ValidateDiagFunc = validateSocialIdentityProviderExtraConfig(&socialIdentityProvider{
+		configKeys: map[string]string{
+			"allow_client_assertion_reuse": supportsClientAssertionReuseConfigKey,
+		},
+	})
+	jwtBearerResource.CreateContext = resourceKeycloakIdentityProviderCreate(getJwtBearerIdentityProviderFromData, setJwtBearerIdentityProviderData)
+	jwtBearerResource.ReadContext = resourceKeycloakIdentityProviderRead(setJwtBearerIdentityProviderData)
+	jwtBearerResource.UpdateContext = resourceKeycloakIdentityProviderUpdate(getJwtBearerIdentityProviderFromData, setJwtBearerIdentityProviderData)
+	return jwtBearerResource
+}
+
+func getJwtBearerIdentityProviderFromData(data *schema.ResourceData, keycloakVersion *version.Version) (*keycloak.IdentityProvider, error) {
+	if err := checkFederatedClientAuthenticationSupported(keycloakVersion); err != nil {
+		return nil, err
+	}
+
+	rec, config := getIdentityProviderFromData(data, keycloakVersion)
+	rec.ProviderId = data.Get("provider_id").(string)
+
+	config.Issuer = data.Get("issuer").(string)
+	config.JwksUrl = data.Get("jwks_url").(string)
+	config.UseJwksUrl = true
+	config.ValidateSignature = true
+	config.HideOnLoginPage = types.KeycloakBoolQuoted(data.Get("hide_on_login_page").(bool))
+	config.ExtraConfig[supportsClientAssertionsConfigKey] = types.KeycloakBoolQuoted(true)
+	config.ExtraConfig[supportsClientAssertionReuseConfigKey] = types.KeycloakBoolQuoted(data.Get("allow_client_assertion_reuse").(bool))
+
+	rec.Config = config
+
+	return rec, nil
+}
+
+func setJwtBearerIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, keycloakVersion *version.Version) error {
+	setIdentityProviderData(data, identityProvider, keycloakVersion)
+	data.Set("provider_id", identityProvider.ProviderId)
+	data.Set("issuer", identityProvider.Config.Issuer)
+	data.Set("jwks_url", identityProvider.Config.JwksUrl)
+
+	allowClientAssertionReuse, _ := identityProvider.Config.ExtraConfig[supportsClientAssertionReuseConfigKey].(string)
+	reuse, _ := strconv.ParseBool(allowClientAssertionReuse)
+	data.Set("allow_client_assertion_reuse", reuse)
+
+	return nil
+}
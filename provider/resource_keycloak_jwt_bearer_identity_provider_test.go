@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakJwtBearerIdentityProvider_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_4)
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_jwt_bearer_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakJwtBearerIdentityProvider_basic(alias, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderExists("keycloak_jwt_bearer_identity_provider.spiffe"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_jwt_bearer_identity_provider.spiffe", "supportsClientAssertions", "true"),
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_jwt_bearer_identity_provider.spiffe", "supportsClientAssertionReuse", "false"),
+				),
+			},
+			{
+				Config: testKeycloakJwtBearerIdentityProvider_basic(alias, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_jwt_bearer_identity_provider.spiffe", "supportsClientAssertionReuse", "true"),
+					resource.TestCheckResourceAttr("keycloak_jwt_bearer_identity_provider.spiffe", "allow_client_assertion_reuse", "true"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_jwt_bearer_identity_provider.spiffe",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testAccRealm.Realm + "/" + alias,
+			},
+		},
+	})
+}
+
+func testKeycloakJwtBearerIdentityProvider_basic(alias string, allowClientAssertionReuse bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_jwt_bearer_identity_provider" "spiffe" {
+	realm    = data.keycloak_realm.realm.id
+	alias    = "%s"
+	issuer   = "spiffe://example.org"
+	jwks_url = "https://spiffe.example.org/keys"
+
+	allow_client_assertion_reuse = %t
+}
+	`, testAccRealm.Realm, alias, allowClientAssertionReuse)
+}
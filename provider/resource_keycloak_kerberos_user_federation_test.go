@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakKerberosUserFederation_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakKerberosUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakKerberosUserFederation_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakKerberosUserFederationExists("keycloak_kerberos_user_federation.kerberos"),
+					resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "kerberos_realm", "FOO.LOCAL"),
+					resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "allow_password_authentication", "false"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_kerberos_user_federation.kerberos",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakKerberosUserFederation_update(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakKerberosUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakKerberosUserFederation_basic(name),
+				Check:  testAccCheckKeycloakKerberosUserFederationExists("keycloak_kerberos_user_federation.kerberos"),
+			},
+			{
+				Config: testKeycloakKerberosUserFederation_passwordAuthentication(name, "UNSYNCED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakKerberosUserFederationExists("keycloak_kerberos_user_federation.kerberos"),
+					resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "allow_password_authentication", "true"),
+					resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "edit_mode", "UNSYNCED"),
+					resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "update_profile_first_login", "true"),
+					resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "debug", "true"),
+				),
+			},
+			{
+				ResourceName:        "keycloak_kerberos_user_federation.kerberos",
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: testAccRealm.Realm + "/",
+			},
+		},
+	})
+}
+
+func TestAccKeycloakKerberosUserFederation_cache(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakKerberosUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakKerberosUserFederation_cache(name, "EVICT_DAILY", 4, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "cache.0.policy", "EVICT_DAILY"),
+					resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "cache.0.eviction_hour", "4"),
+					resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "cache.0.eviction_minute", "30"),
+				),
+			},
+			{
+				Config: testKeycloakKerberosUserFederation_cache(name, "NO_CACHE", -1, -1),
+				Check:  resource.TestCheckResourceAttr("keycloak_kerberos_user_federation.kerberos", "cache.0.policy", "NO_CACHE"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakKerberosUserFederation_editModeRequiresPasswordAuthentication(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakKerberosUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakKerberosUserFederation_editModeOnly(name),
+				ExpectError: regexp.MustCompile("validation error: the edit mode of a kerberos user federation only applies when password authentication is allowed"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakKerberosUserFederationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKerberosUserFederationFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakKerberosUserFederationDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_kerberos_user_federation" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			kerberos, _ := keycloakClient.GetKerberosUserFederation(testCtx, realm, id)
+			if kerberos != nil {
+				return fmt.Errorf("kerberos user federation with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKerberosUserFederationFromState(s *terraform.State, resourceName string) (*keycloak.KerberosUserFederation, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	kerberos, err := keycloakClient.GetKerberosUserFederation(testCtx, realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting kerberos user federation with id %s: %s", id, err)
+	}
+
+	return kerberos, nil
+}
+
+func testKeycloakKerberosUserFederation_basic(name string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_kerberos_user_federation" "kerberos" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	kerberos_realm   = "FOO.LOCAL"
+	server_principal = "HTTP/host.foo.local@FOO.LOCAL"
+	key_tab          = "/etc/host.keytab"
+}
+	`, testAccRealm.Realm, name)
+}
+
+func testKeycloakKerberosUserFederation_passwordAuthentication(name, editMode string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_kerberos_user_federation" "kerberos" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+	priority = 1
+
+	kerberos_realm   = "BAR.LOCAL"
+	server_principal = "HTTP/host.bar.local@BAR.LOCAL"
+	key_tab          = "/etc/bar.keytab"
+	debug            = true
+
+	allow_password_authentication = true
+	edit_mode                     = "%s"
+	update_profile_first_login    = true
+}
+	`, testAccRealm.Realm, name, editMode)
+}
+
+func testKeycloakKerberosUserFederation_cache(name, policy string, evictionHour, evictionMinute int) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_kerberos_user_federation" "kerberos" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	kerberos_realm   = "FOO.LOCAL"
+	server_principal = "HTTP/host.foo.local@FOO.LOCAL"
+	key_tab          = "/etc/host.keytab"
+
+	cache {
+		policy          = "%s"
+		eviction_hour   = %d
+		eviction_minute = %d
+	}
+}
+	`, testAccRealm.Realm, name, policy, evictionHour, evictionMinute)
+}
+
+func testKeycloakKerberosUserFederation_editModeOnly(name string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_kerberos_user_federation" "kerberos" {
+	name     = "%s"
+	realm_id = data.keycloak_realm.realm.id
+
+	kerberos_realm   = "FOO.LOCAL"
+	server_principal = "HTTP/host.foo.local@FOO.LOCAL"
+	key_tab          = "/etc/host.keytab"
+	edit_mode        = "UNSYNCED"
+}
+	`, testAccRealm.Realm, name)
+}
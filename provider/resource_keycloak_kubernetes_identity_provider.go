@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+)
+
+func resourceKeycloakKubernetesIdentityProvider() *schema.Resource {
+	kubernetesSchema := map[string]*schema.Schema{
+		"provider_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "kubernetes",
+			ForceNew:    true,
+			Description: "provider id, is always kubernetes, unless you have a extended custom implementation",
+		},
+		"issuer": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "The issuer of the service account tokens, as reported by the Kubernetes API server.",
+		},
+		"jwks_url": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "The URL of the keys the service account tokens are signed with. Discovered from the issuer when empty.",
+		},
+		"hide_on_login_page": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Hide On Login Page. Users do not log in with this identity provider, it only authenticates clients.",
+		},
+	}
+	kubernetesResource := resourceKeycloakIdentityProvider()
+	kubernetesResource.Schema = mergeSchemas(kubernetesResource.Schema, kubernetesSchema)
+	kubernetesResource.CreateContext = resourceKeycloakIdentityProviderCreate(getKubernetesIdentityProviderFromData, setKubernetesIdentityProviderData)
+	kubernetesResource.ReadContext = resourceKeycloakIdentityProviderRead(setKubernetesIdentityProviderData)
+	kubernetesResource.UpdateContext = resourceKeycloakIdentityProviderUpdate(getKubernetesIdentityProviderFromData, setKubernetesIdentityProviderData)
+	return kubernetesResource
+}
+
+// checkFederatedClientAuthenticationSupported fails for the versions of Keycloak which cannot authenticate clients
+// with tokens issued by identity providers
+func checkFederatedClientAuthenticationSupported(keycloakVersion *version.Version) error {
+	if keycloakVersion.LessThan(keycloak.Version_26_4.AsVersion()) {
+		return fmt.Errorf("federated client authentication requires Keycloak %s or later", keycloak.Version_26_4)
+	}
+
+	return nil
+}
+
+func getKubernetesIdentityProviderFromData(data *schema.ResourceData, keycloakVersion *version.Version) (*keycloak.IdentityProvider, error) {
+	if err := checkFederatedClientAuthenticationSupported(keycloakVersion); err != nil {
+		return nil, err
+	}
+
+	rec, config := getIdentityProviderFromData(data, keycloakVersion)
+	rec.ProviderId = data.Get("provider_id").(string)
+
+	config.Issuer = data.Get("issuer").(string)
+	config.JwksUrl = data.Get("jwks_url").(string)
+	config.UseJwksUrl = types.KeycloakBoolQuoted(config.JwksUrl != "")
+	config.HideOnLoginPage = types.KeycloakBoolQuoted(data.Get("hide_on_login_page").(bool))
+
+	rec.Config = config
+
+	return rec, nil
+}
+
+func setKubernetesIdentityProviderData(data *schema.ResourceData, identityProvider *keycloak.IdentityProvider, keycloakVersion *version.Version) error {
+	setIdentityProviderData(data, identityProvider, keycloakVersion)
+	data.Set("provider_id", identityProvider.ProviderId)
+	data.Set("issuer", identityProvider.Config.Issuer)
+	data.Set("jwks_url", identityProvider.Config.JwksUrl)
+
+	return nil
+}
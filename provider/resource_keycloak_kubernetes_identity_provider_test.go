@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakKubernetesIdentityProvider_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_4)
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_kubernetes_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakKubernetesIdentityProvider_basic(alias, "https://kubernetes.default.svc.cluster.local"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderExists("keycloak_kubernetes_identity_provider.kubernetes"),
+					resource.TestCheckResourceAttr("keycloak_kubernetes_identity_provider.kubernetes", "provider_id", "kubernetes"),
+				),
+			},
+			{
+				Config: testKeycloakKubernetesIdentityProvider_basic(alias, "https://oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE"),
+				Check:  resource.TestCheckResourceAttr("keycloak_kubernetes_identity_provider.kubernetes", "issuer", "https://oidc.eks.eu-west-1.amazonaws.com/id/EXAMPLE"),
+			},
+			{
+				ResourceName:      "keycloak_kubernetes_identity_provider.kubernetes",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testAccRealm.Realm + "/" + alias,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakKubernetesIdentityProvider_federatedClient(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_4)
+	alias := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakKubernetesIdentityProvider_federatedClient(alias, clientId, ""),
+				ExpectError: regexp.MustCompile("validation error: the federated-jwt client authenticator requires an identity provider and a subject"),
+			},
+			{
+				Config: testKeycloakKubernetesIdentityProvider_federatedClient(alias, clientId, "system:serviceaccount:default:my-app"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol("keycloak_openid_client.client"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_authenticator_type", keycloak.FederatedJwtClientAuthenticator),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "federated_identity_provider", alias),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "federated_subject", "system:serviceaccount:default:my-app"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakKubernetesIdentityProvider_basic(alias, issuer string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_kubernetes_identity_provider" "kubernetes" {
+	realm  = data.keycloak_realm.realm.id
+	alias  = "%s"
+	issuer = "%s"
+}
+	`, testAccRealm.Realm, alias, issuer)
+}
+
+func testKeycloakKubernetesIdentityProvider_federatedClient(alias, clientId, subject string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_openid_client" "client" {
+	realm_id                 = data.keycloak_realm.realm.id
+	client_id                = "%s"
+	access_type              = "CONFIDENTIAL"
+	service_accounts_enabled = true
+
+	client_authenticator_type   = "federated-jwt"
+	federated_identity_provider = keycloak_kubernetes_identity_provider.kubernetes.alias
+	federated_subject           = "%s"
+}
+	`, testKeycloakKubernetesIdentityProvider_basic(alias, "https://kubernetes.default.svc.cluster.local"), clientId, subject)
+}
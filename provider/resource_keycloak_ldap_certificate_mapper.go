@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// resourceKeycloakLdapCertificateMapper has the options of the user attribute mapper, the certificate is stored in
+// ldap_attribute and mapped to user_model_attribute
+func resourceKeycloakLdapCertificateMapper() *schema.Resource {
+	resource := resourceKeycloakLdapUserAttributeMapper()
+
+	resource.CreateContext = resourceKeycloakLdapCertificateMapperCreate
+	resource.ReadContext = resourceKeycloakLdapCertificateMapperRead
+	resource.UpdateContext = resourceKeycloakLdapCertificateMapperUpdate
+	resource.DeleteContext = resourceKeycloakLdapCertificateMapperDelete
+
+	resource.Schema["is_der_formatted"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When true, the certificate is DER formatted in LDAP instead of PEM formatted.",
+	}
+
+	return resource
+}
+
+func getLdapCertificateMapperFromData(data *schema.ResourceData) *keycloak.LdapCertificateMapper {
+	return &keycloak.LdapCertificateMapper{
+		LdapUserAttributeMapper: *getLdapUserAttributeMapperFromData(data),
+		IsDerFormatted:          data.Get("is_der_formatted").(bool),
+	}
+}
+
+func setLdapCertificateMapperData(data *schema.ResourceData, ldapCertificateMapper *keycloak.LdapCertificateMapper) {
+	setLdapUserAttributeMapperData(data, &ldapCertificateMapper.LdapUserAttributeMapper)
+
+	data.Set("is_der_formatted", ldapCertificateMapper.IsDerFormatted)
+}
+
+func resourceKeycloakLdapCertificateMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	ldapCertificateMapper := getLdapCertificateMapperFromData(data)
+
+	err := keycloakClient.ValidateLdapCertificateMapper(ldapCertificateMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewLdapCertificateMapper(ctx, ldapCertificateMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setLdapCertificateMapperData(data, ldapCertificateMapper)
+
+	return resourceKeycloakLdapCertificateMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakLdapCertificateMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	ldapCertificateMapper, err := keycloakClient.GetLdapCertificateMapper(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setLdapCertificateMapperData(data, ldapCertificateMapper)
+
+	return nil
+}
+
+func resourceKeycloakLdapCertificateMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	ldapCertificateMapper := getLdapCertificateMapperFromData(data)
+
+	err := keycloakClient.ValidateLdapCertificateMapper(ldapCertificateMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateLdapCertificateMapper(ctx, ldapCertificateMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setLdapCertificateMapperData(data, ldapCertificateMapper)
+
+	return nil
+}
+
+func resourceKeycloakLdapCertificateMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return diag.FromErr(keycloakClient.DeleteLdapCertificateMapper(ctx, realmId, id))
+}
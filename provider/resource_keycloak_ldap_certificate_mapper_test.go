@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakLdapCertificateMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	certificateMapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapCertificateMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapCertificateMapper(certificateMapperName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakLdapCertificateMapperExists("keycloak_ldap_certificate_mapper.certificate_mapper"),
+					resource.TestCheckResourceAttr("keycloak_ldap_certificate_mapper.certificate_mapper", "is_der_formatted", "false"),
+				),
+			},
+			{
+				Config: testKeycloakLdapCertificateMapper(certificateMapperName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakLdapCertificateMapperExists("keycloak_ldap_certificate_mapper.certificate_mapper"),
+					resource.TestCheckResourceAttr("keycloak_ldap_certificate_mapper.certificate_mapper", "is_der_formatted", "true"),
+					resource.TestCheckResourceAttr("keycloak_ldap_certificate_mapper.certificate_mapper", "is_binary_attribute", "true"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_ldap_certificate_mapper.certificate_mapper",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getLdapGenericMapperImportId("keycloak_ldap_certificate_mapper.certificate_mapper"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakLdapCertificateMapper_drift(t *testing.T) {
+	t.Parallel()
+
+	var mapper = &keycloak.LdapCertificateMapper{}
+
+	certificateMapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapCertificateMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapCertificateMapper(certificateMapperName, false, false),
+				Check:  testAccCheckKeycloakLdapCertificateMapperFetch("keycloak_ldap_certificate_mapper.certificate_mapper", mapper),
+			},
+			{
+				PreConfig: func() {
+					mapper.IsDerFormatted = true
+
+					err := keycloakClient.UpdateLdapCertificateMapper(testCtx, mapper)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testKeycloakLdapCertificateMapper(certificateMapperName, false, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKeycloakLdapCertificateMapper(certificateMapperName, false, false),
+				Check:  resource.TestCheckResourceAttr("keycloak_ldap_certificate_mapper.certificate_mapper", "is_der_formatted", "false"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakLdapCertificateMapper_binaryAttributeValidation(t *testing.T) {
+	t.Parallel()
+
+	certificateMapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapCertificateMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakLdapCertificateMapper(certificateMapperName, true, false),
+				ExpectError: regexp.MustCompile("validation error: always_read_value_from_ldap must be true when is_binary_attribute is true"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakLdapCertificateMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getLdapCertificateMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakLdapCertificateMapperFetch(resourceName string, mapper *keycloak.LdapCertificateMapper) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedMapper, err := getLdapCertificateMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		*mapper = *fetchedMapper
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakLdapCertificateMapperDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_ldap_certificate_mapper" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			ldapCertificateMapper, _ := keycloakClient.GetLdapCertificateMapper(testCtx, realm, id)
+			if ldapCertificateMapper != nil {
+				return fmt.Errorf("ldap certificate mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getLdapCertificateMapperFromState(s *terraform.State, resourceName string) (*keycloak.LdapCertificateMapper, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+
+	ldapCertificateMapper, err := keycloakClient.GetLdapCertificateMapper(testCtx, realm, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ldap certificate mapper with id %s: %s", id, err)
+	}
+
+	return ldapCertificateMapper, nil
+}
+
+func testKeycloakLdapCertificateMapper(certificateMapperName string, isDerFormatted, alwaysReadValueFromLdap bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name                    = "openldap"
+	realm_id                = data.keycloak_realm.realm.id
+
+	enabled                 = true
+
+	username_ldap_attribute = "cn"
+	rdn_ldap_attribute      = "cn"
+	uuid_ldap_attribute     = "entryDN"
+	user_object_classes     = [
+		"simpleSecurityObject",
+		"organizationalRole"
+	]
+	connection_url          = "ldap://openldap"
+	users_dn                = "dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "admin"
+}
+
+resource "keycloak_ldap_certificate_mapper" "certificate_mapper" {
+	name                        = "%s"
+	realm_id                    = data.keycloak_realm.realm.id
+	ldap_user_federation_id     = keycloak_ldap_user_federation.openldap.id
+
+	user_model_attribute        = "usercertificate"
+	ldap_attribute              = "userCertificate"
+	read_only                   = true
+	is_der_formatted            = %t
+	is_binary_attribute         = %t
+	always_read_value_from_ldap = %t
+}
+	`, testAccRealmUserFederation.Realm, certificateMapperName, isDerFormatted, isDerFormatted, alwaysReadValueFromLdap)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakLdapKerberosPrincipalAttributeMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakLdapKerberosPrincipalAttributeMapperCreate,
+		ReadContext:   resourceKeycloakLdapKerberosPrincipalAttributeMapperRead,
+		UpdateContext: resourceKeycloakLdapKerberosPrincipalAttributeMapperUpdate,
+		DeleteContext: resourceKeycloakLdapKerberosPrincipalAttributeMapperDelete,
+		// This resource can be imported using {{realm}}/{{provider_id}}/{{mapper_id}}. The Provider and Mapper IDs are displayed in the GUI
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakLdapGenericMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the mapper when displayed in the console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm in which the ldap user federation provider exists.",
+			},
+			"ldap_user_federation_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ldap user federation provider to attach this mapper to.",
+			},
+			"ldap_attribute": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the LDAP attribute holding the kerberos principal of the users, such as krb5PrincipalName or userPrincipalName.",
+			},
+		},
+	}
+}
+
+func getLdapKerberosPrincipalAttributeMapperFromData(data *schema.ResourceData) *keycloak.LdapKerberosPrincipalAttributeMapper {
+	return &keycloak.LdapKerberosPrincipalAttributeMapper{
+		Id:                   data.Id(),
+		Name:                 data.Get("name").(string),
+		RealmId:              data.Get("realm_id").(string),
+		LdapUserFederationId: data.Get("ldap_user_federation_id").(string),
+
+		LdapAttribute: data.Get("ldap_attribute").(string),
+	}
+}
+
+func setLdapKerberosPrincipalAttributeMapperData(data *schema.ResourceData, ldapKerberosPrincipalAttributeMapper *keycloak.LdapKerberosPrincipalAttributeMapper) {
+	data.SetId(ldapKerberosPrincipalAttributeMapper.Id)
+
+	data.Set("name", ldapKerberosPrincipalAttributeMapper.Name)
+	data.Set("realm_id", ldapKerberosPrincipalAttributeMapper.RealmId)
+	data.Set("ldap_user_federation_id", ldapKerberosPrincipalAttributeMapper.LdapUserFederationId)
+
+	data.Set("ldap_attribute", ldapKerberosPrincipalAttributeMapper.LdapAttribute)
+}
+
+func resourceKeycloakLdapKerberosPrincipalAttributeMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	ldapKerberosPrincipalAttributeMapper := getLdapKerberosPrincipalAttributeMapperFromData(data)
+
+	err := keycloakClient.NewLdapKerberosPrincipalAttributeMapper(ctx, ldapKerberosPrincipalAttributeMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setLdapKerberosPrincipalAttributeMapperData(data, ldapKerberosPrincipalAttributeMapper)
+
+	return resourceKeycloakLdapKerberosPrincipalAttributeMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakLdapKerberosPrincipalAttributeMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	ldapKerberosPrincipalAttributeMapper, err := keycloakClient.GetLdapKerberosPrincipalAttributeMapper(ctx, realmId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setLdapKerberosPrincipalAttributeMapperData(data, ldapKerberosPrincipalAttributeMapper)
+
+	return nil
+}
+
+func resourceKeycloakLdapKerberosPrincipalAttributeMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	ldapKerberosPrincipalAttributeMapper := getLdapKerberosPrincipalAttributeMapperFromData(data)
+
+	err := keycloakClient.UpdateLdapKerberosPrincipalAttributeMapper(ctx, ldapKerberosPrincipalAttributeMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setLdapKerberosPrincipalAttributeMapperData(data, ldapKerberosPrincipalAttributeMapper)
+
+	return nil
+}
+
+func resourceKeycloakLdapKerberosPrincipalAttributeMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	id := data.Id()
+
+	return diag.FromErr(keycloakClient.DeleteLdapKerberosPrincipalAttributeMapper(ctx, realmId, id))
+}
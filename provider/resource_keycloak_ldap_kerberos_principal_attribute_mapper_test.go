@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakLdapKerberosPrincipalAttributeMapper_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_22)
+	t.Parallel()
+
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapKerberosPrincipalAttributeMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapKerberosPrincipalAttributeMapper(mapperName, "krb5PrincipalName"),
+				Check:  testAccCheckKeycloakLdapKerberosPrincipalAttributeMapperHasAttribute("keycloak_ldap_kerberos_principal_attribute_mapper.kerberos_principal_mapper", "krb5PrincipalName"),
+			},
+			{
+				Config: testKeycloakLdapKerberosPrincipalAttributeMapper(mapperName, "userPrincipalName"),
+				Check:  testAccCheckKeycloakLdapKerberosPrincipalAttributeMapperHasAttribute("keycloak_ldap_kerberos_principal_attribute_mapper.kerberos_principal_mapper", "userPrincipalName"),
+			},
+			{
+				ResourceName:      "keycloak_ldap_kerberos_principal_attribute_mapper.kerberos_principal_mapper",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getLdapGenericMapperImportId("keycloak_ldap_kerberos_principal_attribute_mapper.kerberos_principal_mapper"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakLdapKerberosPrincipalAttributeMapperHasAttribute(resourceName, ldapAttribute string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		id := rs.Primary.ID
+		realm := rs.Primary.Attributes["realm_id"]
+
+		mapper, err := keycloakClient.GetLdapKerberosPrincipalAttributeMapper(testCtx, realm, id)
+		if err != nil {
+			return fmt.Errorf("error getting ldap kerberos principal attribute mapper with id %s: %s", id, err)
+		}
+
+		if mapper.LdapAttribute != ldapAttribute {
+			return fmt.Errorf("expected ldap kerberos principal attribute mapper to map %s, got %s", ldapAttribute, mapper.LdapAttribute)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakLdapKerberosPrincipalAttributeMapperDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_ldap_kerberos_principal_attribute_mapper" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			mapper, _ := keycloakClient.GetLdapKerberosPrincipalAttributeMapper(testCtx, realm, id)
+			if mapper != nil {
+				return fmt.Errorf("ldap kerberos principal attribute mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakLdapKerberosPrincipalAttributeMapper(mapperName, ldapAttribute string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name                    = "openldap"
+	realm_id                = data.keycloak_realm.realm.id
+
+	enabled                 = true
+
+	username_ldap_attribute = "cn"
+	rdn_ldap_attribute      = "cn"
+	uuid_ldap_attribute     = "entryDN"
+	user_object_classes     = [
+		"simpleSecurityObject",
+		"organizationalRole"
+	]
+	connection_url          = "ldap://openldap"
+	users_dn                = "dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "admin"
+}
+
+resource "keycloak_ldap_kerberos_principal_attribute_mapper" "kerberos_principal_mapper" {
+	name                    = "%s"
+	realm_id                = data.keycloak_realm.realm.id
+	ldap_user_federation_id = keycloak_ldap_user_federation.openldap.id
+
+	ldap_attribute          = "%s"
+}
+	`, testAccRealmUserFederation.Realm, mapperName, ldapAttribute)
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// resourceKeycloakLdapMapperSync synchronizes the groups or roles of an LDAP mapper when it is created, and again
+// every time one of its triggers changes. Destroying it does nothing.
+func resourceKeycloakLdapMapperSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakLdapMapperSyncCreate,
+		ReadContext:   resourceKeycloakLdapMapperSyncRead,
+		DeleteContext: resourceKeycloakLdapMapperSyncDelete,
+		Schema: mergeSchemas(map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ldap_user_federation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ldap_mapper_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      keycloak.LdapMapperSyncFederationToKeycloak,
+				ValidateFunc: validation.StringInSlice([]string{keycloak.LdapMapperSyncFederationToKeycloak, keycloak.LdapMapperSyncKeycloakToFederation}, false),
+				Description:  "fedToKeycloak imports the groups or roles from LDAP into Keycloak, keycloakToFed exports them from Keycloak to LDAP.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that trigger a new synchronization when they change.",
+			},
+		}, synchronizationResultSchema()),
+	}
+}
+
+func resourceKeycloakLdapMapperSyncCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	ldapUserFederationId := data.Get("ldap_user_federation_id").(string)
+	ldapMapperId := data.Get("ldap_mapper_id").(string)
+
+	result, err := keycloakClient.SyncLdapMapper(ctx, realmId, ldapUserFederationId, ldapMapperId, data.Get("direction").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s/%s", realmId, ldapUserFederationId, ldapMapperId))
+	setSynchronizationResultData(data, result)
+
+	return resourceKeycloakLdapMapperSyncRead(ctx, data, meta)
+}
+
+// resourceKeycloakLdapMapperSyncRead only checks that the mapper still exists, the result of the synchronization is
+// kept as is
+func resourceKeycloakLdapMapperSyncRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	_, err := keycloakClient.GetComponent(ctx, data.Get("realm_id").(string), data.Get("ldap_mapper_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakLdapMapperSyncDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
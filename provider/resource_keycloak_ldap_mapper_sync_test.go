@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakLdapMapperSync_groupMapper(t *testing.T) {
+	t.Parallel()
+	groupMapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapGroupMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapMapperSync_groupMapper(groupMapperName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_ldap_mapper_sync.sync", "ignored", "false"),
+					resource.TestCheckResourceAttr("keycloak_ldap_mapper_sync.sync", "failed", "0"),
+					resource.TestCheckResourceAttr("keycloak_ldap_mapper_sync.sync", "direction", "fedToKeycloak"),
+				),
+			},
+			{
+				Config: testKeycloakLdapMapperSync_groupMapper(groupMapperName, "2"),
+				Check:  resource.TestCheckResourceAttr("keycloak_ldap_mapper_sync.sync", "failed", "0"),
+			},
+		},
+	})
+}
+
+func testKeycloakLdapMapperSync_groupMapper(groupMapperName, trigger string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name                    = "openldap"
+	realm_id                = data.keycloak_realm.realm.id
+
+	enabled                 = true
+
+	username_ldap_attribute = "cn"
+	rdn_ldap_attribute      = "cn"
+	uuid_ldap_attribute     = "entryDN"
+	user_object_classes     = [
+		"simpleSecurityObject",
+		"organizationalRole"
+	]
+	connection_url          = "ldap://openldap"
+	users_dn                = "dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "admin"
+}
+
+resource "keycloak_ldap_group_mapper" "group_mapper" {
+	name                        = "%s"
+	realm_id                    = data.keycloak_realm.realm.id
+	ldap_user_federation_id     = keycloak_ldap_user_federation.openldap.id
+
+	ldap_groups_dn                 = "dc=example,dc=org"
+	group_name_ldap_attribute      = "cn"
+	group_object_classes           = [
+		"groupOfNames"
+	]
+	membership_attribute_type      = "DN"
+	membership_ldap_attribute      = "member"
+	membership_user_ldap_attribute = "cn"
+	memberof_ldap_attribute        = "memberOf"
+}
+
+resource "keycloak_ldap_mapper_sync" "sync" {
+	realm_id                = data.keycloak_realm.realm.id
+	ldap_user_federation_id = keycloak_ldap_user_federation.openldap.id
+	ldap_mapper_id          = keycloak_ldap_group_mapper.group_mapper.id
+
+	triggers = {
+		revision = "%s"
+	}
+}
+	`, testAccRealmUserFederation.Realm, groupMapperName, trigger)
+}
@@ -26,6 +26,7 @@ func resourceKeycloakLdapUserFederation() *schema.Resource {
 		ReadContext:   resourceKeycloakLdapUserFederationRead,
 		UpdateContext: resourceKeycloakLdapUserFederationUpdate,
 		DeleteContext: resourceKeycloakLdapUserFederationDelete,
+		CustomizeDiff: resourceKeycloakLdapUserFederationTestConnection,
 		// If this resource uses authentication, then this resource must be imported using the syntax {{realm_id}}/{{provider_id}}/{{bind_credential}}
 		// Otherwise, this resource can be imported using {{realm}}/{{provider_id}}.
 		// The Provider ID is displayed in the GUI when editing this provider
@@ -292,10 +293,59 @@ func resourceKeycloakLdapUserFederation() *schema.Resource {
 				ForceNew:    true,
 				Description: "When true, the provider will delete the default mappers which are normally created by Keycloak when creating an LDAP user federation provider.",
 			},
+			"test_connection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, Keycloak tests the connection and the authentication to the LDAP server when planning the creation of this provider or a change of its connection settings.",
+			},
 		},
 	}
 }
 
+// ldapUserFederationConnectionAttributes are the attributes used by Keycloak to connect to the LDAP server
+var ldapUserFederationConnectionAttributes = []string{"realm_id", "connection_url", "bind_dn", "bind_credential", "start_tls", "use_truststore_spi", "connection_timeout"}
+
+// resourceKeycloakLdapUserFederationTestConnection asks Keycloak to connect to the LDAP server, and to authenticate
+// with the bind DN when set, so that unreachable servers and wrong credentials are reported at plan time
+func resourceKeycloakLdapUserFederationTestConnection(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("test_connection").(bool) {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("test_connection") && !diff.HasChanges(ldapUserFederationConnectionAttributes...) {
+		return nil
+	}
+
+	for _, attribute := range ldapUserFederationConnectionAttributes {
+		if !diff.NewValueKnown(attribute) {
+			return nil
+		}
+	}
+
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+	realmId := diff.Get("realm_id").(string)
+
+	// the connection is tested on apply when the realm is created along with this provider
+	if _, err := keycloakClient.GetRealm(ctx, realmId); err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return keycloakClient.TestLdapUserFederationConnection(ctx, realmId, &keycloak.LdapUserFederation{
+		Id:                diff.Id(),
+		ConnectionUrl:     diff.Get("connection_url").(string),
+		BindDn:            diff.Get("bind_dn").(string),
+		BindCredential:    diff.Get("bind_credential").(string),
+		StartTls:          diff.Get("start_tls").(bool),
+		UseTruststoreSpi:  diff.Get("use_truststore_spi").(string),
+		ConnectionTimeout: diff.Get("connection_timeout").(string),
+	})
+}
+
 func validateSyncPeriod(i interface{}, k string) (s []string, errs []error) {
 	num, ok := i.(int)
 	if !ok {
@@ -477,6 +527,13 @@ func resourceKeycloakLdapUserFederationCreate(ctx context.Context, data *schema.
 		return diag.FromErr(err)
 	}
 
+	if data.Get("test_connection").(bool) {
+		err = keycloakClient.TestLdapUserFederationConnection(ctx, realmId, ldap)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err = keycloakClient.NewLdapUserFederation(ctx, realmId, ldap)
 	if err != nil {
 		return diag.FromErr(err)
@@ -572,6 +629,7 @@ func resourceKeycloakLdapUserFederationImport(ctx context.Context, d *schema.Res
 
 	d.Set("realm_id", realmId)
 	d.Set("delete_default_mappers", false) // this is only valid on create, so we assume this is false
+	d.Set("test_connection", false)
 	d.SetId(id)
 
 	diagnostics := resourceKeycloakLdapUserFederationRead(ctx, d, meta)
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// resourceKeycloakLdapUserFederationSync synchronizes the users of an LDAP user federation when it is created, and
+// again every time one of its triggers changes. Destroying it does nothing.
+func resourceKeycloakLdapUserFederationSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakLdapUserFederationSyncCreate,
+		ReadContext:   resourceKeycloakLdapUserFederationSyncRead,
+		DeleteContext: resourceKeycloakLdapUserFederationSyncDelete,
+		Schema: mergeSchemas(map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ldap_user_federation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      keycloak.LdapUserFederationFullSync,
+				ValidateFunc: validation.StringInSlice([]string{keycloak.LdapUserFederationFullSync, keycloak.LdapUserFederationChangedUsersSync}, false),
+				Description:  "triggerFullSync synchronizes all the users, triggerChangedUsersSync only the users changed since the last synchronization.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that trigger a new synchronization when they change.",
+			},
+		}, synchronizationResultSchema()),
+	}
+}
+
+// synchronizationResultSchema holds the counts returned by the last synchronization
+func synchronizationResultSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ignored": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True when the synchronization was skipped, for instance because another one was running.",
+		},
+		"added": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"updated": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"removed": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"failed": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func setSynchronizationResultData(data *schema.ResourceData, result *keycloak.SynchronizationResult) {
+	data.Set("ignored", result.Ignored)
+	data.Set("added", result.Added)
+	data.Set("updated", result.Updated)
+	data.Set("removed", result.Removed)
+	data.Set("failed", result.Failed)
+	data.Set("status", result.Status)
+}
+
+func resourceKeycloakLdapUserFederationSyncCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	ldapUserFederationId := data.Get("ldap_user_federation_id").(string)
+
+	result, err := keycloakClient.SyncLdapUserFederation(ctx, realmId, ldapUserFederationId, data.Get("action").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, ldapUserFederationId))
+	setSynchronizationResultData(data, result)
+
+	return resourceKeycloakLdapUserFederationSyncRead(ctx, data, meta)
+}
+
+// resourceKeycloakLdapUserFederationSyncRead only checks that the user federation still exists, the result of the
+// synchronization is kept as is
+func resourceKeycloakLdapUserFederationSyncRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	_, err := keycloakClient.GetLdapUserFederation(ctx, data.Get("realm_id").(string), data.Get("ldap_user_federation_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakLdapUserFederationSyncDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakLdapUserFederationSync_basic(t *testing.T) {
+	t.Parallel()
+	ldapName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakLdapUserFederationSync(ldapName, keycloak.LdapUserFederationFullSync, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_ldap_user_federation_sync.sync", "ignored", "false"),
+					resource.TestCheckResourceAttr("keycloak_ldap_user_federation_sync.sync", "failed", "0"),
+					resource.TestCheckResourceAttrSet("keycloak_ldap_user_federation_sync.sync", "added"),
+					resource.TestCheckResourceAttrSet("keycloak_ldap_user_federation_sync.sync", "status"),
+				),
+			},
+			{
+				Config: testKeycloakLdapUserFederationSync(ldapName, keycloak.LdapUserFederationFullSync, "1"),
+				// the synchronization only runs again when a trigger changes
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakLdapUserFederationSync(ldapName, keycloak.LdapUserFederationChangedUsersSync, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_ldap_user_federation_sync.sync", "action", keycloak.LdapUserFederationChangedUsersSync),
+					resource.TestCheckResourceAttr("keycloak_ldap_user_federation_sync.sync", "added", "0"),
+					resource.TestCheckResourceAttr("keycloak_ldap_user_federation_sync.sync", "failed", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakLdapUserFederationSync(ldap, action, trigger string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name                    = "%s"
+	realm_id                = data.keycloak_realm.realm.id
+
+	enabled                 = true
+
+	username_ldap_attribute = "cn"
+	rdn_ldap_attribute      = "cn"
+	uuid_ldap_attribute     = "entryDN"
+	user_object_classes     = [
+		"simpleSecurityObject",
+		"organizationalRole"
+	]
+	connection_url          = "ldap://openldap"
+	users_dn                = "dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "admin"
+}
+
+resource "keycloak_ldap_user_federation_sync" "sync" {
+	realm_id                = data.keycloak_realm.realm.id
+	ldap_user_federation_id = keycloak_ldap_user_federation.openldap.id
+	action                  = "%s"
+
+	triggers = {
+		revision = "%s"
+	}
+}
+	`, testAccRealmUserFederation.Realm, ldap, action, trigger)
+}
@@ -547,6 +547,35 @@ func TestAccKeycloakLdapUserFederation_bindCredential(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakLdapUserFederation_testConnection(t *testing.T) {
+	t.Parallel()
+	ldapName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakLdapUserFederationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakLdapUserFederation_testConnection(ldapName, "ldap://unknown-ldap-host", "admin"),
+				ExpectError: regexp.MustCompile("unable to connect to the LDAP server ldap://unknown-ldap-host"),
+			},
+			{
+				Config:      testKeycloakLdapUserFederation_testConnection(ldapName, "ldap://openldap", "wrong-password"),
+				ExpectError: regexp.MustCompile("unable to authenticate to the LDAP server ldap://openldap as cn=admin,dc=example,dc=org"),
+			},
+			{
+				Config: testKeycloakLdapUserFederation_testConnection(ldapName, "ldap://openldap", "admin"),
+				Check:  testAccCheckKeycloakLdapUserFederationExists("keycloak_ldap_user_federation.openldap"),
+			},
+			{
+				Config:      testKeycloakLdapUserFederation_testConnection(ldapName, "ldap://openldap", "wrong-password"),
+				ExpectError: regexp.MustCompile("unable to authenticate to the LDAP server ldap://openldap as cn=admin,dc=example,dc=org"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakLdapUserFederationExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getLdapUserFederationFromState(s, resourceName)
@@ -894,6 +923,36 @@ resource "keycloak_ldap_user_federation" "openldap" {
 	`, testAccRealmUserFederation.Realm, ldap, bindCredential)
 }
 
+func testKeycloakLdapUserFederation_testConnection(ldap, connectionUrl, bindCredential string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_ldap_user_federation" "openldap" {
+	name                    = "%s"
+	realm_id                = data.keycloak_realm.realm.id
+
+	enabled                 = true
+
+	username_ldap_attribute = "cn"
+	rdn_ldap_attribute      = "cn"
+	uuid_ldap_attribute     = "entryDN"
+	user_object_classes     = [
+		"simpleSecurityObject",
+		"organizationalRole"
+	]
+	connection_url          = "%s"
+	users_dn                = "dc=example,dc=org"
+	bind_dn                 = "cn=admin,dc=example,dc=org"
+	bind_credential         = "%s"
+	connection_timeout      = "5s"
+
+	test_connection         = true
+}
+	`, testAccRealmUserFederation.Realm, ldap, connectionUrl, bindCredential)
+}
+
 func testKeycloakLdapUserFederation_noAuth(ldap string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakMicrosoftIdentityProvider() *schema.Resource {
+	return resourceKeycloakSocialIdentityProvider(&socialIdentityProvider{
+		providerId: "microsoft",
+		schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The tenant id of the Microsoft Entra ID directory, to only accept the accounts of this tenant. Any Microsoft account is accepted when empty.",
+			},
+		},
+		configKeys: map[string]string{
+			"tenant_id": "tenantId",
+		},
+	})
+}
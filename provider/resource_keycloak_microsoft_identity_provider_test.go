@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakMicrosoftIdentityProvider_tenant(t *testing.T) {
+	alias := acctest.RandomWithPrefix("tf-acc")
+	tenantId := "b5a8a0c2-4b4e-4f5e-9d7c-2f1f9b2c3d4e"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_microsoft_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_microsoft_identity_provider", alias, `tenant_id = "`+tenantId+`"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_microsoft_identity_provider.social", "tenantId", tenantId),
+					resource.TestCheckResourceAttr("keycloak_microsoft_identity_provider.social", "tenant_id", tenantId),
+				),
+			},
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_microsoft_identity_provider", alias, ""),
+				Check:  testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_microsoft_identity_provider.social", "tenantId", ""),
+			},
+		},
+	})
+}
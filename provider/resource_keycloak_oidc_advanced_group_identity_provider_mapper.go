@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOidcAdvancedGroupIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"claims": identityProviderMapperKeyValuesSchema("Claims which must all be present in the token, the value of a claim may be a regex when claim_values_regex is true"),
+		"claim_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the values of the claims are regexes",
+		},
+		"group": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Group Path",
+		},
+		"sync_mode":    identityProviderMapperSyncModeSchema(),
+		"extra_config": identityProviderMapperExtraConfigSchema(advancedIdentityProviderMapperClaimsKey, advancedIdentityProviderMapperClaimValuesRegexKey),
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getOidcAdvancedGroupIdentityProviderMapperFromData, setOidcAdvancedGroupIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setOidcAdvancedGroupIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getOidcAdvancedGroupIdentityProviderMapperFromData, setOidcAdvancedGroupIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getOidcAdvancedGroupIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rec, _ := getIdentityProviderMapperFromData(data)
+	_, err := getIdentityProviderMapperIdentityProvider(ctx, keycloakClient, "keycloak_oidc_advanced_group_identity_provider_mapper", rec, isOidcIdentityProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "oidc-advanced-group-idp-mapper"
+	rec.Config.Group = data.Get("group").(string)
+	rec.Config.ExtraConfig[advancedIdentityProviderMapperClaimValuesRegexKey] = strconv.FormatBool(data.Get("claim_values_regex").(bool))
+	getIdentityProviderMapperSyncModeFromData(data, rec)
+
+	err = rec.Config.SetKeyValues(advancedIdentityProviderMapperClaimsKey, getIdentityProviderMapperKeyValuesFromData(data, "claims"))
+	if err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+func setOidcAdvancedGroupIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	claims, err := identityProviderMapper.Config.GetKeyValues(advancedIdentityProviderMapperClaimsKey)
+	if err != nil {
+		return err
+	}
+
+	claimValuesRegex, err := parseIdentityProviderMapperBool(identityProviderMapper, advancedIdentityProviderMapperClaimValuesRegexKey)
+	if err != nil {
+		return err
+	}
+
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	setIdentityProviderMapperSyncModeData(data, identityProviderMapper)
+	setIdentityProviderMapperKeyValuesData(data, "claims", claims)
+	data.Set("claim_values_regex", claimValuesRegex)
+	data.Set("group", identityProviderMapper.Config.Group)
+
+	return nil
+}
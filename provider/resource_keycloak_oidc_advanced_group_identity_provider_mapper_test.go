@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakOidcAdvancedGroupIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_oidc_advanced_group_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_oidc_advanced_group_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcAdvancedGroupIdentityProviderMapper_basic(alias, mapperName, groupName, "engineering", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "claims.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "claims.0.key", "department"),
+					resource.TestCheckResourceAttr(resourceName, "claims.0.value", "engineering"),
+					resource.TestCheckResourceAttr(resourceName, "claim_values_regex", "false"),
+					resource.TestCheckResourceAttr(resourceName, "group", "/"+groupName),
+					resource.TestCheckResourceAttr(resourceName, "sync_mode", "INHERIT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcAdvancedGroupIdentityProviderMapper_update(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_oidc_advanced_group_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_oidc_advanced_group_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcAdvancedGroupIdentityProviderMapper_basic(alias, mapperName, groupName, "engineering", false),
+				Check:  resource.TestCheckResourceAttr(resourceName, "claims.0.value", "engineering"),
+			},
+			{
+				Config: testKeycloakOidcAdvancedGroupIdentityProviderMapper_basic(alias, mapperName, groupName, "eng.*", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "claims.0.value", "eng.*"),
+					resource.TestCheckResourceAttr(resourceName, "claim_values_regex", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakOidcAdvancedGroupIdentityProviderMapper_basic(alias, name, groupName, departmentClaimValue string, claimValuesRegex bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_oidc_advanced_group_identity_provider_mapper" "mapper" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	group                   = keycloak_group.group.path
+
+	claims {
+		key   = "department"
+		value = "%s"
+	}
+
+	claim_values_regex = %t
+}
+	`, testAccRealm.Realm, alias, groupName, name, departmentClaimValue, claimValuesRegex)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOidcAdvancedRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"claims": identityProviderMapperKeyValuesSchema("Claims which must all be present in the token, the value of a claim may be a regex when claim_values_regex is true"),
+		"claim_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the values of the claims are regexes",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name",
+		},
+		"sync_mode":    identityProviderMapperSyncModeSchema(),
+		"extra_config": identityProviderMapperExtraConfigSchema(advancedIdentityProviderMapperClaimsKey, advancedIdentityProviderMapperClaimValuesRegexKey),
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getOidcAdvancedRoleIdentityProviderMapperFromData, setOidcAdvancedRoleIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setOidcAdvancedRoleIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getOidcAdvancedRoleIdentityProviderMapperFromData, setOidcAdvancedRoleIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getOidcAdvancedRoleIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rec, _ := getIdentityProviderMapperFromData(data)
+	_, err := getIdentityProviderMapperIdentityProvider(ctx, keycloakClient, "keycloak_oidc_advanced_role_identity_provider_mapper", rec, isOidcIdentityProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "oidc-advanced-role-idp-mapper"
+	rec.Config.Role = data.Get("role").(string)
+	rec.Config.ExtraConfig[advancedIdentityProviderMapperClaimValuesRegexKey] = strconv.FormatBool(data.Get("claim_values_regex").(bool))
+	getIdentityProviderMapperSyncModeFromData(data, rec)
+
+	err = rec.Config.SetKeyValues(advancedIdentityProviderMapperClaimsKey, getIdentityProviderMapperKeyValuesFromData(data, "claims"))
+	if err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+func setOidcAdvancedRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	claims, err := identityProviderMapper.Config.GetKeyValues(advancedIdentityProviderMapperClaimsKey)
+	if err != nil {
+		return err
+	}
+
+	claimValuesRegex, err := parseIdentityProviderMapperBool(identityProviderMapper, advancedIdentityProviderMapperClaimValuesRegexKey)
+	if err != nil {
+		return err
+	}
+
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	setIdentityProviderMapperSyncModeData(data, identityProviderMapper)
+	setIdentityProviderMapperKeyValuesData(data, "claims", claims)
+	data.Set("claim_values_regex", claimValuesRegex)
+	data.Set("role", identityProviderMapper.Config.Role)
+
+	return nil
+}
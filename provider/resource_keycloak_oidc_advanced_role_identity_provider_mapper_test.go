@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOidcAdvancedRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_oidc_advanced_role_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_oidc_advanced_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcAdvancedRoleIdentityProviderMapper_basic(alias, mapperName, roleName, "admins|operators", true, "FORCE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "claims.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "claims.0.key", "groups"),
+					resource.TestCheckResourceAttr(resourceName, "claims.0.value", "admins|operators"),
+					resource.TestCheckResourceAttr(resourceName, "claims.1.key", "department"),
+					resource.TestCheckResourceAttr(resourceName, "claim_values_regex", "true"),
+					resource.TestCheckResourceAttr(resourceName, "role", roleName),
+					resource.TestCheckResourceAttr(resourceName, "sync_mode", "FORCE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcAdvancedRoleIdentityProviderMapper_update(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_oidc_advanced_role_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_oidc_advanced_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcAdvancedRoleIdentityProviderMapper_basic(alias, mapperName, roleName, "admins", false, "INHERIT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "claims.0.value", "admins"),
+					resource.TestCheckResourceAttr(resourceName, "claim_values_regex", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sync_mode", "INHERIT"),
+				),
+			},
+			{
+				Config: testKeycloakOidcAdvancedRoleIdentityProviderMapper_basic(alias, mapperName, roleName, "admin.*", true, "IMPORT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "claims.0.value", "admin.*"),
+					resource.TestCheckResourceAttr(resourceName, "claim_values_regex", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sync_mode", "IMPORT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcAdvancedRoleIdentityProviderMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var mapper = &keycloak.IdentityProviderMapper{}
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_oidc_advanced_role_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_oidc_advanced_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcAdvancedRoleIdentityProviderMapper_basic(alias, mapperName, roleName, "admins", false, "INHERIT"),
+				Check:  testAccCheckKeycloakIdentityProviderMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteIdentityProviderMapper(testCtx, mapper.Realm, mapper.IdentityProviderAlias, mapper.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakOidcAdvancedRoleIdentityProviderMapper_basic(alias, mapperName, roleName, "admins", false, "INHERIT"),
+				Check:  testAccCheckKeycloakIdentityProviderMapperExists(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcAdvancedRoleIdentityProviderMapper_validation(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_oidc_advanced_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOidcAdvancedRoleIdentityProviderMapper_basic(alias, mapperName, roleName, "admins", false, "ALWAYS"),
+				ExpectError: regexp.MustCompile(`expected sync_mode to be one of`),
+			},
+			{
+				Config:      testKeycloakOidcAdvancedRoleIdentityProviderMapper_syncModeInExtraConfig(alias, mapperName, roleName),
+				ExpectError: regexp.MustCompile(`extra_config key "syncMode" is not allowed`),
+			},
+			{
+				Config:      testKeycloakOidcAdvancedRoleIdentityProviderMapper_samlIdentityProvider(alias, mapperName, roleName),
+				ExpectError: regexp.MustCompile(`"saml" identity provider is not supported`),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakIdentityProviderMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getKeycloakIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakIdentityProviderMapperFetch(resourceName string, mapper *keycloak.IdentityProviderMapper) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedMapper, err := getKeycloakIdentityProviderMapperFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.IdentityProviderAlias = fetchedMapper.IdentityProviderAlias
+		mapper.Realm = fetchedMapper.Realm
+		mapper.Id = fetchedMapper.Id
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakIdentityProviderMapperDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm"]
+			alias := rs.Primary.Attributes["identity_provider_alias"]
+			id := rs.Primary.ID
+
+			mapper, _ := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+			if mapper != nil {
+				return fmt.Errorf("identity provider mapper with id %s still exists", id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getKeycloakIdentityProviderMapperFromState(s *terraform.State, resourceName string) (*keycloak.IdentityProviderMapper, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	realm := rs.Primary.Attributes["realm"]
+	alias := rs.Primary.Attributes["identity_provider_alias"]
+	id := rs.Primary.ID
+
+	mapper, err := keycloakClient.GetIdentityProviderMapper(testCtx, realm, alias, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting identity provider mapper with id %s: %s", id, err)
+	}
+
+	return mapper, nil
+}
+
+func getIdentityProviderMapperImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm"]
+		alias := rs.Primary.Attributes["identity_provider_alias"]
+
+		return fmt.Sprintf("%s/%s/%s", realm, alias, rs.Primary.ID), nil
+	}
+}
+
+func testKeycloakOidcAdvancedRoleIdentityProviderMapper_basic(alias, name, roleName, groupsClaimValue string, claimValuesRegex bool, syncMode string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_oidc_advanced_role_identity_provider_mapper" "mapper" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	role                    = keycloak_role.role.name
+
+	claims {
+		key   = "groups"
+		value = "%s"
+	}
+
+	claims {
+		key   = "department"
+		value = "it"
+	}
+
+	claim_values_regex = %t
+	sync_mode          = "%s"
+}
+	`, testAccRealm.Realm, alias, roleName, name, groupsClaimValue, claimValuesRegex, syncMode)
+}
+
+func testKeycloakOidcAdvancedRoleIdentityProviderMapper_syncModeInExtraConfig(alias, name, roleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_oidc_advanced_role_identity_provider_mapper" "mapper" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	role                    = "%s"
+
+	claims {
+		key   = "groups"
+		value = "admins"
+	}
+
+	extra_config = {
+		syncMode = "FORCE"
+	}
+}
+	`, testAccRealm.Realm, alias, name, roleName)
+}
+
+func testKeycloakOidcAdvancedRoleIdentityProviderMapper_samlIdentityProvider(alias, name, roleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_oidc_advanced_role_identity_provider_mapper" "mapper" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	role                    = "%s"
+
+	claims {
+		key   = "groups"
+		value = "admins"
+	}
+}
+	`, testAccRealm.Realm, alias, name, roleName)
+}
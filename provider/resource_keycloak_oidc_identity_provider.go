@@ -10,6 +10,19 @@ import (
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
 )
 
+// oidcMetadata lists the attributes imported from the OpenID Connect discovery document of the identity provider
+var oidcMetadata = &identityProviderMetadata{
+	attributes: map[string]string{
+		"authorization_url": "authorizationUrl",
+		"token_url":         "tokenUrl",
+		"logout_url":        "logoutUrl",
+		"user_info_url":     "userInfoUrl",
+		"jwks_url":          "jwksUrl",
+		"issuer":            "issuer",
+	},
+	required: []string{"authorization_url", "token_url"},
+}
+
 func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 	oidcSchema := map[string]*schema.Schema{
 		"provider_id": {
@@ -141,6 +154,7 @@ func resourceKeycloakOidcIdentityProvider() *schema.Resource {
 	oidcResource.CreateContext = resourceKeycloakIdentityProviderCreate(getOidcIdentityProviderFromData, setOidcIdentityProviderData)
 	oidcResource.ReadContext = resourceKeycloakIdentityProviderRead(setOidcIdentityProviderData)
 	oidcResource.UpdateContext = resourceKeycloakIdentityProviderUpdate(getOidcIdentityProviderFromData, setOidcIdentityProviderData)
+	oidcMetadata.addMetadataUrl(oidcResource)
 	oidcResource.ValidateRawResourceConfigFuncs = []schema.ValidateRawResourceConfigFunc{
 		// validate that argument is required if none of the checkExists attributes exist
 		requiredWithoutAll(cty.GetAttrPath("client_secret"), []cty.Path{cty.GetAttrPath("client_secret_wo"), cty.GetAttrPath("client_secret_wo_version")}),
@@ -189,6 +203,8 @@ func getOidcIdentityProviderFromData(data *schema.ResourceData, keycloakVersion
 		return nil, err
 	}
 
+	getMetadataUrlFromData(data, oidcIdentityProviderConfig)
+
 	rec.Config = oidcIdentityProviderConfig
 
 	return rec, nil
@@ -209,6 +225,7 @@ func setOidcIdentityProviderData(data *schema.ResourceData, identityProvider *ke
 	data.Set("ui_locales", identityProvider.Config.UILocales)
 	data.Set("issuer", identityProvider.Config.Issuer)
 	data.Set("disable_type_claim_check", identityProvider.Config.DisableTypeClaimCheck)
+	setMetadataUrlData(data, identityProvider.Config)
 
 	if v, ok := data.GetOk("client_secret_wo_version"); ok && v != nil {
 		data.Set("client_secret_wo_version", v.(int))
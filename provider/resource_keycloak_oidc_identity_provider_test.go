@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"strconv"
 	"testing"
@@ -268,6 +269,102 @@ func TestAccKeycloakOidcIdentityProvider_clientSecretWriteOnly(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOidcIdentityProvider_metadataUrl(t *testing.T) {
+	t.Parallel()
+
+	oidcName := acctest.RandomWithPrefix("tf-acc")
+	realmUrl := regexp.QuoteMeta("/realms/" + testAccRealm.Realm)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcIdentityProvider_metadataUrl(oidcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOidcIdentityProviderExists("keycloak_oidc_identity_provider.oidc"),
+					resource.TestMatchResourceAttr("keycloak_oidc_identity_provider.oidc", "authorization_url", regexp.MustCompile(realmUrl+"/protocol/openid-connect/auth$")),
+					resource.TestMatchResourceAttr("keycloak_oidc_identity_provider.oidc", "token_url", regexp.MustCompile(realmUrl+"/protocol/openid-connect/token$")),
+					resource.TestMatchResourceAttr("keycloak_oidc_identity_provider.oidc", "jwks_url", regexp.MustCompile(realmUrl+"/protocol/openid-connect/certs$")),
+					resource.TestMatchResourceAttr("keycloak_oidc_identity_provider.oidc", "issuer", regexp.MustCompile(realmUrl+"$")),
+				),
+			},
+			{
+				ResourceName:            "keycloak_oidc_identity_provider.oidc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           testAccRealm.Realm + "/" + oidcName,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+			{
+				Config: testKeycloakOidcIdentityProvider_basic(oidcName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "metadata_url", ""),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "authorization_url", "https://example.com/auth"),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "jwks_url", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcIdentityProvider_adopt(t *testing.T) {
+	t.Parallel()
+
+	existingIdentityProvider := &keycloak.IdentityProvider{
+		Realm:      testAccRealm.Realm,
+		Alias:      acctest.RandomWithPrefix("tf-acc"),
+		ProviderId: "oidc",
+		Enabled:    true,
+		Config: &keycloak.IdentityProviderConfig{
+			AuthorizationUrl: "https://example.com/auth",
+			TokenUrl:         "https://example.com/token",
+			ClientId:         "example_id",
+			ClientSecret:     "example_token",
+			SyncMode:         "IMPORT",
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOidcIdentityProviderNotDestroyed(),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					err := keycloakClient.NewIdentityProvider(testCtx, existingIdentityProvider)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					t.Cleanup(func() {
+						keycloakClient.DeleteIdentityProvider(testCtx, existingIdentityProvider.Realm, existingIdentityProvider.Alias)
+					})
+				},
+				// the adopted identity provider is updated with the configuration
+				Config: testKeycloakOidcIdentityProvider_adopt(existingIdentityProvider.Alias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "alias", existingIdentityProvider.Alias),
+					resource.TestCheckResourceAttr("keycloak_oidc_identity_provider.oidc", "issuer", "hello"),
+					func(s *terraform.State) error {
+						identityProvider, err := getKeycloakOidcIdentityProviderFromState(s, "keycloak_oidc_identity_provider.oidc")
+						if err != nil {
+							return err
+						}
+
+						if identityProvider.Config.Issuer != "hello" {
+							return fmt.Errorf("expected identity provider %s to have issuer hello, but got %s", identityProvider.Alias, identityProvider.Config.Issuer)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakOidcIdentityProviderExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getKeycloakOidcIdentityProviderFromState(s, resourceName)
@@ -358,6 +455,27 @@ func testAccCheckKeycloakOidcIdentityProviderDestroy() resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckKeycloakOidcIdentityProviderNotDestroyed checks that adopted identity providers are left in place
+func testAccCheckKeycloakOidcIdentityProviderNotDestroyed() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_oidc_identity_provider" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm"]
+
+			oidc, _ := keycloakClient.GetIdentityProvider(testCtx, realm, id)
+			if oidc == nil {
+				return fmt.Errorf("oidc config with id %s does not exist", id)
+			}
+		}
+
+		return nil
+	}
+}
+
 func getKeycloakOidcIdentityProviderFromState(s *terraform.State, resourceName string) (*keycloak.IdentityProvider, error) {
 	rs, ok := s.RootModule().Resources[resourceName]
 	if !ok {
@@ -507,3 +625,39 @@ resource "keycloak_oidc_identity_provider" "oidc" {
 }
 	`, testAccRealm.Realm, oidc, clientSecretWriteOnly, clientSecretWriteOnlyVersion)
 }
+
+func testKeycloakOidcIdentityProvider_metadataUrl(oidc string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm         = data.keycloak_realm.realm.id
+	alias         = "%s"
+	metadata_url  = "%s/realms/%s/.well-known/openid-configuration"
+	client_id     = "example_id"
+	client_secret = "example_token"
+}
+	`, testAccRealm.Realm, oidc, os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm)
+}
+
+func testKeycloakOidcIdentityProvider_adopt(oidc string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+	import            = true
+
+	issuer = "hello"
+}
+	`, testAccRealm.Realm, oidc)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+const oidcUsernameIdentityProviderMapperTargetKey = "target"
+
+func resourceKeycloakOidcUsernameIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"template": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Template used to format the username, such as ${CLAIM.preferred_username}",
+		},
+		"target": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "LOCAL",
+			ValidateFunc: validation.StringInSlice([]string{"LOCAL", "BROKER_ID", "BROKER_USERNAME"}, false),
+			Description:  "Destination of the formatted username",
+		},
+		"sync_mode":    identityProviderMapperSyncModeSchema(),
+		"extra_config": identityProviderMapperExtraConfigSchema(oidcUsernameIdentityProviderMapperTargetKey),
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getOidcUsernameIdentityProviderMapperFromData, setOidcUsernameIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setOidcUsernameIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getOidcUsernameIdentityProviderMapperFromData, setOidcUsernameIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getOidcUsernameIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rec, _ := getIdentityProviderMapperFromData(data)
+	_, err := getIdentityProviderMapperIdentityProvider(ctx, keycloakClient, "keycloak_oidc_username_identity_provider_mapper", rec, func(providerId string) bool {
+		return isOidcIdentityProvider(providerId) || isSocialIdentityProvider(providerId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "oidc-username-idp-mapper"
+	rec.Config.Template = data.Get("template").(string)
+	rec.Config.ExtraConfig[oidcUsernameIdentityProviderMapperTargetKey] = data.Get("target").(string)
+	getIdentityProviderMapperSyncModeFromData(data, rec)
+
+	return rec, nil
+}
+
+func setOidcUsernameIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	target, _ := identityProviderMapper.Config.ExtraConfig[oidcUsernameIdentityProviderMapperTargetKey].(string)
+	if target == "" {
+		target = "LOCAL"
+	}
+
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	setIdentityProviderMapperSyncModeData(data, identityProviderMapper)
+	data.Set("template", identityProviderMapper.Config.Template)
+	data.Set("target", target)
+
+	return nil
+}
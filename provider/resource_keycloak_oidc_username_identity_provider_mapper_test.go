@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakOidcUsernameIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_oidc_username_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_oidc_username_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcUsernameIdentityProviderMapper_basic(alias, mapperName, "$${ALIAS}.$${CLAIM.preferred_username}", "LOCAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template", "${ALIAS}.${CLAIM.preferred_username}"),
+					resource.TestCheckResourceAttr(resourceName, "target", "LOCAL"),
+					resource.TestCheckResourceAttr(resourceName, "sync_mode", "INHERIT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOidcUsernameIdentityProviderMapper_update(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_oidc_username_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_oidc_username_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOidcUsernameIdentityProviderMapper_basic(alias, mapperName, "$${CLAIM.preferred_username}", "LOCAL"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "target", "LOCAL"),
+			},
+			{
+				Config: testKeycloakOidcUsernameIdentityProviderMapper_basic(alias, mapperName, "$${CLAIM.email}", "BROKER_USERNAME"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "template", "${CLAIM.email}"),
+					resource.TestCheckResourceAttr(resourceName, "target", "BROKER_USERNAME"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakOidcUsernameIdentityProviderMapper_basic(alias, name, template, target string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "oidc" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_oidc_username_identity_provider_mapper" "mapper" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_oidc_identity_provider.oidc.alias
+	template                = "%s"
+	target                  = "%s"
+}
+	`, testAccRealm.Realm, alias, name, template, target)
+}
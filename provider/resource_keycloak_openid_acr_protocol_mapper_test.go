@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdAcrProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_acr_protocol_mapper.acr_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAcrProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAcrProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAcrProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAcrProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_acr_protocol_mapper.acr_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAcrProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAcrProtocolMapper_clientScope(clientScopeName, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAcrProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAcrProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_acr_protocol_mapper.acr_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAcrProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAcrProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdAcrProtocolMapperExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAcrProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+	updatedValue := "false"
+
+	resourceName := "keycloak_openid_acr_protocol_mapper.acr_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAcrProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAcrProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAcrProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", value),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdAcrProtocolMapper_client(clientId, mapperName, updatedValue),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAcrProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_id_token", updatedValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAcrProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.OpenIdAcrProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_acr_protocol_mapper.acr_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAcrProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAcrProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdAcrProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteOpenIdAcrProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakOpenIdAcrProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdAcrProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+func testAccKeycloakOpenIdAcrProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_acr_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getOpenIdAcrMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid acr protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAcrProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getOpenIdAcrMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAcrProtocolMapperFetch(resourceName string, mapper *keycloak.OpenIdAcrProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getOpenIdAcrMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getOpenIdAcrMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdAcrProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdAcrProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdAcrProtocolMapper_client(clientId, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_acr_protocol_mapper" "acr_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	add_to_id_token            = %s
+	add_to_token_introspection = false
+}`, testAccRealm.Realm, clientId, mapperName, value)
+}
+
+func testKeycloakOpenIdAcrProtocolMapper_clientScope(clientScopeName, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_openid_acr_protocol_mapper" "acr_mapper" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+
+	add_to_id_token            = %s
+	add_to_token_introspection = false
+}`, testAccRealm.Realm, clientScopeName, mapperName, value)
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenIdAddressProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenIdAddressProtocolMapperCreate,
+		ReadContext:   resourceKeycloakOpenIdAddressProtocolMapperRead,
+		UpdateContext: resourceKeycloakOpenIdAddressProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakOpenIdAddressProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-friendly name that will appear in the Keycloak console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm id where the associated client or client scope exists.",
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client. Cannot be used at the same time as client_scope_id.",
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client scope. Cannot be used at the same time as client_id.",
+				ConflictsWith: []string{"client_id"},
+			},
+			"add_to_id_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the claim should be added to the id token.",
+			},
+			"add_to_access_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the claim should be added to the access token.",
+			},
+			"add_to_userinfo": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the claim should appear in the userinfo response body.",
+			},
+			"add_to_token_introspection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the claim should be added to the token introspection response body.",
+			},
+			"formatted_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "formatted",
+				Description: "The user attribute holding the full mailing address of the address.",
+			},
+			"street_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "street",
+				Description: "The user attribute holding the street of the address.",
+			},
+			"locality_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "locality",
+				Description: "The user attribute holding the city or locality of the address.",
+			},
+			"region_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "region",
+				Description: "The user attribute holding the state, province or region of the address.",
+			},
+			"postal_code_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "postal_code",
+				Description: "The user attribute holding the postal code of the address.",
+			},
+			"country_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "country",
+				Description: "The user attribute holding the country of the address.",
+			},
+		},
+	}
+}
+
+func mapFromDataToOpenIdAddressProtocolMapper(data *schema.ResourceData) *keycloak.OpenIdAddressProtocolMapper {
+	return &keycloak.OpenIdAddressProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		AddToIdToken:            data.Get("add_to_id_token").(bool),
+		AddToAccessToken:        data.Get("add_to_access_token").(bool),
+		AddToUserInfo:           data.Get("add_to_userinfo").(bool),
+		AddToTokenIntrospection: data.Get("add_to_token_introspection").(bool),
+		FormattedAttribute:      data.Get("formatted_attribute").(string),
+		StreetAttribute:         data.Get("street_attribute").(string),
+		LocalityAttribute:       data.Get("locality_attribute").(string),
+		RegionAttribute:         data.Get("region_attribute").(string),
+		PostalCodeAttribute:     data.Get("postal_code_attribute").(string),
+		CountryAttribute:        data.Get("country_attribute").(string),
+	}
+}
+
+func mapFromOpenIdAddressProtocolMapperToData(mapper *keycloak.OpenIdAddressProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("add_to_id_token", mapper.AddToIdToken)
+	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_userinfo", mapper.AddToUserInfo)
+	data.Set("add_to_token_introspection", mapper.AddToTokenIntrospection)
+	data.Set("formatted_attribute", mapper.FormattedAttribute)
+	data.Set("street_attribute", mapper.StreetAttribute)
+	data.Set("locality_attribute", mapper.LocalityAttribute)
+	data.Set("region_attribute", mapper.RegionAttribute)
+	data.Set("postal_code_attribute", mapper.PostalCodeAttribute)
+	data.Set("country_attribute", mapper.CountryAttribute)
+}
+
+func resourceKeycloakOpenIdAddressProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdAddressMapper := mapFromDataToOpenIdAddressProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdAddressProtocolMapper(ctx, openIdAddressMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewOpenIdAddressProtocolMapper(ctx, openIdAddressMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromOpenIdAddressProtocolMapperToData(openIdAddressMapper, data)
+
+	return resourceKeycloakOpenIdAddressProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdAddressProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	openIdAddressMapper, err := keycloakClient.GetOpenIdAddressProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOpenIdAddressProtocolMapperToData(openIdAddressMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakOpenIdAddressProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdAddressMapper := mapFromDataToOpenIdAddressProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdAddressProtocolMapper(ctx, openIdAddressMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateOpenIdAddressProtocolMapper(ctx, openIdAddressMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOpenIdAddressProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdAddressProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteOpenIdAddressProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_address_protocol_mapper.address_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "street_attribute", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_address_protocol_mapper.address_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_clientScope(clientScopeName, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "street_attribute", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_address_protocol_mapper.address_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+	updatedValue := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_address_protocol_mapper.address_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "street_attribute", value),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_client(clientId, mapperName, updatedValue),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "street_attribute", updatedValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAddressProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.OpenIdAddressProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_openid_address_protocol_mapper.address_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAddressProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAddressProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdAddressProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteOpenIdAddressProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakOpenIdAddressProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdAddressProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+func testAccKeycloakOpenIdAddressProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_address_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getOpenIdAddressMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid address protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAddressProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getOpenIdAddressMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAddressProtocolMapperFetch(resourceName string, mapper *keycloak.OpenIdAddressProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getOpenIdAddressMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getOpenIdAddressMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdAddressProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdAddressProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdAddressProtocolMapper_client(clientId, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_address_protocol_mapper" "address_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	street_attribute  = "%s"
+	country_attribute = "countryCode"
+}`, testAccRealm.Realm, clientId, mapperName, value)
+}
+
+func testKeycloakOpenIdAddressProtocolMapper_clientScope(clientScopeName, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_openid_address_protocol_mapper" "address_mapper" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+
+	street_attribute  = "%s"
+	country_attribute = "countryCode"
+}`, testAccRealm.Realm, clientScopeName, mapperName, value)
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdAllowedOriginsProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_allowed_origins_protocol_mapper.allowed_origins_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAllowedOriginsProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAllowedOriginsProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_allowed_origins_protocol_mapper.allowed_origins_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAllowedOriginsProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_clientScope(clientScopeName, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAllowedOriginsProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_allowed_origins_protocol_mapper.allowed_origins_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAllowedOriginsProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAllowedOriginsProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+	updatedValue := "false"
+
+	resourceName := "keycloak_openid_allowed_origins_protocol_mapper.allowed_origins_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAllowedOriginsProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", value),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_client(clientId, mapperName, updatedValue),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_token_introspection", updatedValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdAllowedOriginsProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.OpenIdAllowedOriginsProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_allowed_origins_protocol_mapper.allowed_origins_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdAllowedOriginsProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdAllowedOriginsProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteOpenIdAllowedOriginsProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakOpenIdAllowedOriginsProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+func testAccKeycloakOpenIdAllowedOriginsProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_allowed_origins_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getOpenIdAllowedOriginsMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid allowed origins protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAllowedOriginsProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getOpenIdAllowedOriginsMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdAllowedOriginsProtocolMapperFetch(resourceName string, mapper *keycloak.OpenIdAllowedOriginsProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getOpenIdAllowedOriginsMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getOpenIdAllowedOriginsMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdAllowedOriginsProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdAllowedOriginsProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdAllowedOriginsProtocolMapper_client(clientId, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_allowed_origins_protocol_mapper" "allowed_origins_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	add_to_token_introspection = %s
+}`, testAccRealm.Realm, clientId, mapperName, value)
+}
+
+func testKeycloakOpenIdAllowedOriginsProtocolMapper_clientScope(clientScopeName, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_openid_allowed_origins_protocol_mapper" "allowed_origins_mapper" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+
+	add_to_token_introspection = %s
+}`, testAccRealm.Realm, clientScopeName, mapperName, value)
+}
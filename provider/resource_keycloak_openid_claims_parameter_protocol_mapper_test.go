@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdClaimsParameterProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_claims_parameter_protocol_mapper.claims_parameter_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdClaimsParameterProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_userinfo", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdClaimsParameterProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_claims_parameter_protocol_mapper.claims_parameter_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdClaimsParameterProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_clientScope(clientScopeName, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_userinfo", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdClaimsParameterProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_claims_parameter_protocol_mapper.claims_parameter_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdClaimsParameterProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdClaimsParameterProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+	updatedValue := "false"
+
+	resourceName := "keycloak_openid_claims_parameter_protocol_mapper.claims_parameter_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdClaimsParameterProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_userinfo", value),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_client(clientId, mapperName, updatedValue),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_to_userinfo", updatedValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdClaimsParameterProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.OpenIdClaimsParameterProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_claims_parameter_protocol_mapper.claims_parameter_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdClaimsParameterProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdClaimsParameterProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteOpenIdClaimsParameterProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakOpenIdClaimsParameterProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+func testAccKeycloakOpenIdClaimsParameterProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_claims_parameter_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getOpenIdClaimsParameterMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid claims parameter protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdClaimsParameterProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getOpenIdClaimsParameterMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdClaimsParameterProtocolMapperFetch(resourceName string, mapper *keycloak.OpenIdClaimsParameterProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getOpenIdClaimsParameterMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getOpenIdClaimsParameterMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdClaimsParameterProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdClaimsParameterProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdClaimsParameterProtocolMapper_client(clientId, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_claims_parameter_protocol_mapper" "claims_parameter_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	add_to_userinfo = %s
+}`, testAccRealm.Realm, clientId, mapperName, value)
+}
+
+func testKeycloakOpenIdClaimsParameterProtocolMapper_clientScope(clientScopeName, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_openid_claims_parameter_protocol_mapper" "claims_parameter_mapper" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+
+	add_to_userinfo = %s
+}`, testAccRealm.Realm, clientScopeName, mapperName, value)
+}
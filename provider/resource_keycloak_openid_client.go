@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -70,21 +71,21 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"client_secret_wo", "client_secret_wo_version", "client_secret_regenerate_when_changed"},
+				ConflictsWith: []string{"client_secret_wo", "client_secret_wo_version", "client_secret_regenerate_when_changed", "client_secret_rotate_when_changed"},
 			},
 			"client_secret_wo": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
 				WriteOnly:     true,
-				ConflictsWith: []string{"client_secret", "client_secret_regenerate_when_changed"},
+				ConflictsWith: []string{"client_secret", "client_secret_regenerate_when_changed", "client_secret_rotate_when_changed"},
 				RequiredWith:  []string{"client_secret_wo_version"},
 				Description:   "Client Secret as write-only argument",
 			},
 			"client_secret_wo_version": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"client_secret", "client_secret_regenerate_when_changed"},
+				ConflictsWith: []string{"client_secret", "client_secret_regenerate_when_changed", "client_secret_rotate_when_changed"},
 				RequiredWith:  []string{"client_secret_wo"},
 				Description:   "Version of the Client secret write-only argument",
 			},
@@ -92,11 +93,44 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Type:          schema.TypeMap,
 				Description:   "Arbitrary map of values that, when changed, will trigger rotation of the secret",
 				Optional:      true,
-				ConflictsWith: []string{"client_secret", "client_secret_wo", "client_secret_wo_version"},
+				ConflictsWith: []string{"client_secret", "client_secret_wo", "client_secret_wo_version", "client_secret_rotate_when_changed"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"client_secret_rotate_when_changed": {
+				Type:          schema.TypeMap,
+				Description:   "Arbitrary map of values that, when changed, will rotate the secret, keeping the previous one as rotated secret until it expires. Requires a client policy with the secret-rotation executor.",
+				Optional:      true,
+				ConflictsWith: []string{"client_secret", "client_secret_wo", "client_secret_wo_version", "client_secret_regenerate_when_changed"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"client_secret_invalidate_rotated_when_changed": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, will invalidate the rotated secret before it expires",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"client_secret_expiration_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the secret expires at, in seconds since the epoch, when a client policy with the secret-rotation executor applies to the client",
+			},
+			"client_secret_rotated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The previous secret of the client, still accepted until it expires",
+			},
+			"client_secret_rotated_expiration_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the rotated secret expires at, in seconds since the epoch",
+			},
 			"client_authenticator_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -293,6 +327,32 @@ func resourceKeycloakOpenidClient() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"federated_identity_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Alias of the identity provider issuing the tokens the client authenticates with, when client_authenticator_type is federated-jwt.",
+			},
+			"federated_subject": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Subject of the tokens the client authenticates with, when client_authenticator_type is federated-jwt.",
+			},
+			"use_jwks_url": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, the keys of the client used by the client-jwt authenticator are fetched from jwks_url instead of its uploaded certificate.",
+			},
+			"jwks_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the JSON Web Key Set of the client, used when use_jwks_url is true.",
+			},
+			"tls_client_auth_subject_dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Regular expression matching the subject DN of the certificate of the client, when client_authenticator_type is client-x509.",
+			},
 			"standard_token_exchange_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -359,7 +419,16 @@ func resourceKeycloakOpenidClientDiff() schema.CustomizeDiffFunc {
 			return d.HasChange("service_accounts_enabled")
 		}),
 		customdiff.ComputedIf("client_secret", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-			return d.HasChange("client_secret_regenerate_when_changed")
+			return d.HasChanges("client_secret_regenerate_when_changed", "client_secret_rotate_when_changed")
+		}),
+		customdiff.ComputedIf("client_secret_expiration_time", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChanges("client_secret_regenerate_when_changed", "client_secret_rotate_when_changed")
+		}),
+		customdiff.ComputedIf("client_secret_rotated", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChanges("client_secret_regenerate_when_changed", "client_secret_rotate_when_changed", "client_secret_invalidate_rotated_when_changed")
+		}),
+		customdiff.ComputedIf("client_secret_rotated_expiration_time", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChanges("client_secret_regenerate_when_changed", "client_secret_rotate_when_changed", "client_secret_invalidate_rotated_when_changed")
 		}),
 	)
 }
@@ -423,6 +492,11 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 			UseRefreshTokensClientCredentials:        types.KeycloakBoolQuoted(data.Get("use_refresh_tokens_client_credentials").(bool)),
 			StandardTokenExchangeEnabled:             types.KeycloakBoolQuoted(data.Get("standard_token_exchange_enabled").(bool)),
 			AllowRefreshTokenInStandardTokenExchange: data.Get("allow_refresh_token_in_standard_token_exchange").(string),
+			JwtCredentialIssuer:                      data.Get("federated_identity_provider").(string),
+			JwtCredentialSubject:                     data.Get("federated_subject").(string),
+			UseJwksUrl:                               types.KeycloakBoolQuoted(data.Get("use_jwks_url").(bool)),
+			JwksUrl:                                  data.Get("jwks_url").(string),
+			TlsClientAuthSubjectDn:                   data.Get("tls_client_auth_subject_dn").(string),
 			FrontchannelLogoutUrl:                    data.Get("frontchannel_logout_url").(string),
 			BackchannelLogoutUrl:                     data.Get("backchannel_logout_url").(string),
 			BackchannelLogoutRevokeOfflineTokens:     types.KeycloakBoolQuoted(data.Get("backchannel_logout_revoke_offline_sessions").(bool)),
@@ -538,6 +612,11 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 	data.Set("use_refresh_tokens_client_credentials", client.Attributes.UseRefreshTokensClientCredentials)
 	data.Set("standard_token_exchange_enabled", client.Attributes.StandardTokenExchangeEnabled)
 	data.Set("allow_refresh_token_in_standard_token_exchange", client.Attributes.AllowRefreshTokenInStandardTokenExchange)
+	data.Set("federated_identity_provider", client.Attributes.JwtCredentialIssuer)
+	data.Set("federated_subject", client.Attributes.JwtCredentialSubject)
+	data.Set("use_jwks_url", client.Attributes.UseJwksUrl)
+	data.Set("jwks_url", client.Attributes.JwksUrl)
+	data.Set("tls_client_auth_subject_dn", client.Attributes.TlsClientAuthSubjectDn)
 	data.Set("oauth2_device_authorization_grant_enabled", client.Attributes.Oauth2DeviceAuthorizationGrantEnabled)
 	data.Set("oauth2_device_code_lifespan", client.Attributes.Oauth2DeviceCodeLifespan)
 	data.Set("oauth2_device_polling_interval", client.Attributes.Oauth2DevicePollingInterval)
@@ -569,6 +648,11 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 		data.Set("client_secret", client.ClientSecret)
 	}
 
+	err := setOpenidClientRotatedSecretData(ctx, keycloakClient, data, client)
+	if err != nil {
+		return err
+	}
+
 	// access typess
 	if client.PublicClient {
 		data.Set("access_type", "PUBLIC")
@@ -673,17 +757,18 @@ func resourceKeycloakOpenidClientUpdate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	err = keycloakClient.UpdateOpenidClient(ctx, client)
-	if err != nil {
-		return diag.FromErr(err)
+	diags := evaluateSecretRotation(ctx, keycloakClient, data, client)
+	if diags.HasError() {
+		return diags
 	}
 
-	err = setOpenidClientData(ctx, keycloakClient, data, client)
+	err = keycloakClient.UpdateOpenidClient(ctx, client)
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	return nil
+	// the client is read back, as the secret expiration times are set by Keycloak and absent from the configuration
+	return append(diags, resourceKeycloakOpenidClientRead(ctx, data, meta)...)
 }
 
 func resourceKeycloakOpenidClientDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -737,3 +822,98 @@ func evaluateSecretRegeneration(ctx context.Context, keycloakClient *keycloak.Ke
 
 	return nil
 }
+
+// evaluateSecretRotation invalidates the rotated secret, then rotates the secret, when their triggers change. Keycloak
+// only keeps the previous secret when a client policy with the secret-rotation executor applies to the client, so a
+// rotation without rotated secret is reported as a warning.
+func evaluateSecretRotation(ctx context.Context, keycloakClient *keycloak.KeycloakClient, d *schema.ResourceData, client *keycloak.OpenidClient) diag.Diagnostics {
+	if d.HasChange("client_secret_invalidate_rotated_when_changed") {
+		err := keycloakClient.InvalidateOpenidClientRotatedSecret(ctx, client.RealmId, client.Id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if !d.HasChange("client_secret_rotate_when_changed") {
+		return nil
+	}
+
+	secret, err := keycloakClient.RegenerateOpenIdClientSecret(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client.ClientSecret = secret.Value
+	d.Set("client_secret", secret.Value)
+
+	rotatedSecret, err := keycloakClient.GetOpenidClientRotatedSecret(ctx, client.RealmId, client.Id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if rotatedSecret == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Client secret regenerated without rotation",
+			Detail:   fmt.Sprintf("the previous secret of client %s was not kept as rotated secret, as no client policy with the secret-rotation executor applies to it", client.ClientId),
+		}}
+	}
+
+	return nil
+}
+
+func setOpenidClientRotatedSecretData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, client *keycloak.OpenidClient) error {
+	data.Set("client_secret_expiration_time", getOpenidClientSecretExpirationTime(client, keycloak.OpenidClientSecretExpirationTimeAttribute))
+
+	if client.PublicClient || !openidClientMayHaveRotatedSecret(data, client) {
+		data.Set("client_secret_rotated", "")
+		data.Set("client_secret_rotated_expiration_time", 0)
+
+		return nil
+	}
+
+	rotatedSecret, err := keycloakClient.GetOpenidClientRotatedSecret(ctx, client.RealmId, client.Id)
+	if err != nil {
+		return err
+	}
+
+	if rotatedSecret == nil {
+		data.Set("client_secret_rotated", "")
+		data.Set("client_secret_rotated_expiration_time", 0)
+	} else {
+		data.Set("client_secret_rotated", rotatedSecret.Value)
+		data.Set("client_secret_rotated_expiration_time", getOpenidClientSecretExpirationTime(client, keycloak.OpenidClientSecretRotatedExpirationTimeAttribute))
+	}
+
+	return nil
+}
+
+// openidClientMayHaveRotatedSecret returns whether the rotated secret of a client needs to be fetched: Keycloak sets the
+// expiration time of the rotated secret along with it, and the secret rotation attributes of the resource rotate it
+func openidClientMayHaveRotatedSecret(data *schema.ResourceData, client *keycloak.OpenidClient) bool {
+	if _, ok := client.Attributes.ExtraConfig[keycloak.OpenidClientSecretRotatedExpirationTimeAttribute]; ok {
+		return true
+	}
+
+	for _, attribute := range []string{"client_secret_rotate_when_changed", "client_secret_invalidate_rotated_when_changed", "client_secret_rotated"} {
+		if _, ok := data.GetOk(attribute); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getOpenidClientSecretExpirationTime(client *keycloak.OpenidClient, attribute string) int {
+	value, ok := client.Attributes.ExtraConfig[attribute].(string)
+	if !ok {
+		return 0
+	}
+
+	expirationTime, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+
+	return expirationTime
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenidClientAuthorizationRegexPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenidClientAuthorizationRegexPolicyCreate,
+		ReadContext:   resourceKeycloakOpenidClientAuthorizationRegexPolicyRead,
+		DeleteContext: resourceKeycloakOpenidClientAuthorizationRegexPolicyDelete,
+		UpdateContext: resourceKeycloakOpenidClientAuthorizationRegexPolicyUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: genericResourcePolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"decision_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakOpenidClientResourcePermissionDecisionStrategies, false),
+				Default:      "UNANIMOUS",
+			},
+			"logic": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(keycloakPolicyLogicTypes, false),
+				Default:      "POSITIVE",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target_claim": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// the pattern is a Java regular expression, which isn't validated here as Go regular expressions differ
+			"pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_context_attributes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func getOpenidClientAuthorizationRegexPolicyResourceFromData(data *schema.ResourceData) *keycloak.OpenidClientAuthorizationRegexPolicy {
+	return &keycloak.OpenidClientAuthorizationRegexPolicy{
+		Id:                      data.Id(),
+		ResourceServerId:        data.Get("resource_server_id").(string),
+		RealmId:                 data.Get("realm_id").(string),
+		DecisionStrategy:        data.Get("decision_strategy").(string),
+		Logic:                   data.Get("logic").(string),
+		Name:                    data.Get("name").(string),
+		Type:                    "regex",
+		TargetClaim:             data.Get("target_claim").(string),
+		Pattern:                 data.Get("pattern").(string),
+		TargetContextAttributes: data.Get("target_context_attributes").(bool),
+		Description:             data.Get("description").(string),
+	}
+}
+
+func setOpenidClientAuthorizationRegexPolicyResourceData(data *schema.ResourceData, policy *keycloak.OpenidClientAuthorizationRegexPolicy) {
+	data.SetId(policy.Id)
+
+	data.Set("resource_server_id", policy.ResourceServerId)
+	data.Set("realm_id", policy.RealmId)
+	data.Set("name", policy.Name)
+	data.Set("decision_strategy", policy.DecisionStrategy)
+	data.Set("logic", policy.Logic)
+	data.Set("description", policy.Description)
+	data.Set("target_claim", policy.TargetClaim)
+	data.Set("pattern", policy.Pattern)
+	data.Set("target_context_attributes", policy.TargetContextAttributes)
+}
+
+func resourceKeycloakOpenidClientAuthorizationRegexPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getOpenidClientAuthorizationRegexPolicyResourceFromData(data)
+
+	err := keycloakClient.NewOpenidClientAuthorizationRegexPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setOpenidClientAuthorizationRegexPolicyResourceData(data, policy)
+
+	return resourceKeycloakOpenidClientAuthorizationRegexPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenidClientAuthorizationRegexPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+	id := data.Id()
+
+	policy, err := keycloakClient.GetOpenidClientAuthorizationRegexPolicy(ctx, realmId, resourceServerId, id)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setOpenidClientAuthorizationRegexPolicyResourceData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientAuthorizationRegexPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	policy := getOpenidClientAuthorizationRegexPolicyResourceFromData(data)
+
+	err := keycloakClient.UpdateOpenidClientAuthorizationRegexPolicy(ctx, policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setOpenidClientAuthorizationRegexPolicyResourceData(data, policy)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientAuthorizationRegexPolicyDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	resourceServerId := data.Get("resource_server_id").(string)
+	id := data.Id()
+
+	return diag.FromErr(keycloakClient.DeleteOpenidClientAuthorizationRegexPolicy(ctx, realmId, resourceServerId, id))
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+var keycloakOpenidClientCertificateSources = []string{"certificate_pem", "public_key_pem", "jwks", "generate"}
+
+// resourceKeycloakOpenidClientCertificate cannot be imported, as neither the uploaded keys nor the generated private
+// key can be read back from Keycloak
+func resourceKeycloakOpenidClientCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenidClientCertificateCreate,
+		ReadContext:   resourceKeycloakOpenidClientCertificateRead,
+		DeleteContext: resourceKeycloakOpenidClientCertificateDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the client, not its client_id.",
+			},
+			"certificate_pem": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: keycloakOpenidClientCertificateSources,
+				Description:  "A PEM encoded certificate to upload.",
+			},
+			"public_key_pem": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: keycloakOpenidClientCertificateSources,
+				Description:  "A PEM encoded public key to upload.",
+			},
+			"jwks": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: keycloakOpenidClientCertificateSources,
+				Description:  "A JSON Web Key Set to upload, its signing key is used.",
+			},
+			"generate": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: keycloakOpenidClientCertificateSources,
+				Description:  "When true, Keycloak generates a key pair and a self-signed certificate.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded DER certificate of the client.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded DER public key of the client.",
+			},
+			"kid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key generated by Keycloak, which Keycloak does not keep.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakOpenidClientCertificateCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	var certificate *keycloak.OpenidClientCertificate
+	var err error
+	if data.Get("generate").(bool) {
+		certificate, err = keycloakClient.GenerateOpenidClientCertificate(ctx, realmId, clientId)
+	} else if certificatePem, ok := data.GetOk("certificate_pem"); ok {
+		certificate, err = keycloakClient.UploadOpenidClientCertificate(ctx, realmId, clientId, keycloak.OpenidClientCertificatePemFormat, []byte(certificatePem.(string)))
+	} else if publicKeyPem, ok := data.GetOk("public_key_pem"); ok {
+		certificate, err = keycloakClient.UploadOpenidClientCertificate(ctx, realmId, clientId, keycloak.OpenidClientPublicKeyPemFormat, []byte(publicKeyPem.(string)))
+	} else if jwks, ok := data.GetOk("jwks"); ok {
+		certificate, err = keycloakClient.UploadOpenidClientCertificate(ctx, realmId, clientId, keycloak.OpenidClientJwksFormat, []byte(jwks.(string)))
+	} else {
+		return diag.Errorf("one of %v must be specified", keycloakOpenidClientCertificateSources)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, clientId))
+
+	if certificate.PrivateKey != "" {
+		der, err := base64.StdEncoding.DecodeString(certificate.PrivateKey)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		data.Set("private_key", string(pem.EncodeToMemory(&pem.Block{
+			Type:  "PRIVATE KEY",
+			Bytes: der,
+		})))
+	}
+
+	return resourceKeycloakOpenidClientCertificateRead(ctx, data, meta)
+}
+
+// resourceKeycloakOpenidClientCertificateRead removes the resource from the state when the keys of the client were
+// removed, any other change made outside of Terraform is only reflected in the computed attributes
+func resourceKeycloakOpenidClientCertificateRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	certificate, err := keycloakClient.GetOpenidClientCertificate(ctx, data.Get("realm_id").(string), data.Get("client_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if certificate.Certificate == "" && certificate.PublicKey == "" {
+		data.SetId("")
+
+		return nil
+	}
+
+	data.Set("certificate", certificate.Certificate)
+	data.Set("public_key", certificate.PublicKey)
+	data.Set("kid", certificate.Kid)
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientCertificateDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.DeleteOpenidClientCertificate(ctx, data.Get("realm_id").(string), data.Get("client_id").(string))
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
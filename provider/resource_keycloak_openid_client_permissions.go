@@ -62,8 +62,13 @@ func resourceKeycloakOpenidClientPermissionsReconcile(ctx context.Context, data
 	realmId := data.Get("realm_id").(string)
 	clientId := data.Get("client_id").(string)
 
+	err := checkAdminPermissionsV1(ctx, keycloakClient, realmId, "Clients")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// the existence of this resource implies that permissions are enabled for this client.
-	err := keycloakClient.EnableOpenidClientPermissions(ctx, realmId, clientId)
+	err = keycloakClient.EnableOpenidClientPermissions(ctx, realmId, clientId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
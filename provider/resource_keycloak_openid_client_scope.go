@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"dario.cat/mergo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
@@ -49,6 +50,16 @@ func resourceKeycloakOpenidClientScope() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"import": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"keep_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -100,9 +111,30 @@ func resourceKeycloakOpenidClientScopeCreate(ctx context.Context, data *schema.R
 
 	clientScope := getOpenidClientScopeFromData(data)
 
-	err := keycloakClient.NewOpenidClientScope(ctx, clientScope)
-	if err != nil {
-		return diag.FromErr(err)
+	if !data.Get("import").(bool) {
+		err := keycloakClient.NewOpenidClientScope(ctx, clientScope)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		existingClientScopeId, err := keycloakClient.GetClientScopeIdByName(ctx, clientScope.RealmId, clientScope.Name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		existingClientScope, err := keycloakClient.GetOpenidClientScope(ctx, clientScope.RealmId, existingClientScopeId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = mergo.Merge(clientScope, existingClientScope); err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = keycloakClient.UpdateOpenidClientScope(ctx, clientScope)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	setOpenidClientScopeData(data, clientScope)
@@ -123,6 +155,10 @@ func resourceKeycloakOpenidClientScopeRead(ctx context.Context, data *schema.Res
 
 	setOpenidClientScopeData(data, clientScope)
 
+	if _, ok := data.GetOk("import"); !ok {
+		data.Set("import", false)
+	}
+
 	return nil
 }
 
@@ -142,6 +178,10 @@ func resourceKeycloakOpenidClientScopeUpdate(ctx context.Context, data *schema.R
 }
 
 func resourceKeycloakOpenidClientScopeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if keepOnDestroy(data.GetRawState()) {
+		return nil
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
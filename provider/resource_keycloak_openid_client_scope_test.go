@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -67,6 +68,29 @@ func TestAccKeycloakClientScope_createAfterManualDestroy(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakClientScope_import(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakClientScopeNotDestroyed(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakClientScope_import("non-existing-client-scope"),
+				ExpectError: regexp.MustCompile("Error: Client scope with name non-existing-client-scope not found"),
+			},
+			{
+				Config: testKeycloakClientScope_import("profile"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakClientScopeExistsWithCorrectProtocol("keycloak_openid_client_scope.client_scope"),
+					resource.TestCheckResourceAttr("keycloak_openid_client_scope.client_scope", "name", "profile"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakClientScope_updateRealm(t *testing.T) {
 	t.Parallel()
 	clientScopeName := acctest.RandomWithPrefix("tf-acc")
@@ -251,6 +275,26 @@ func testAccCheckKeycloakClientScopeBelongsToRealm(resourceName, realm string) r
 	}
 }
 
+func testAccCheckKeycloakClientScopeNotDestroyed() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_openid_client_scope" {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm_id"]
+
+			clientScope, _ := keycloakClient.GetOpenidClientScope(testCtx, realm, id)
+			if clientScope == nil {
+				return fmt.Errorf("openid client scope %s does not exist", id)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakClientScopeDestroy() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -389,3 +433,18 @@ resource "keycloak_openid_client_scope" "client_scope" {
 }
 	`, testAccRealm.Realm, testAccRealmTwo.Realm, clientScopeName)
 }
+
+func testKeycloakClientScope_import(clientScopeName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	name        = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	description = "OpenID Connect built-in scope: profile"
+	import      = true
+}
+	`, testAccRealm.Realm, clientScopeName)
+}
@@ -176,6 +176,38 @@ func TestAccKeycloakOpenidClient_clientAuthenticatorType(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClient_clientCredentialKeys(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_jwksUrl(clientId, true, "https://example.com/jwks"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "use_jwks_url", "true"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "jwks_url", "https://example.com/jwks"),
+				),
+			},
+			{
+				Config:      testKeycloakOpenidClient_jwksUrl(clientId, true, ""),
+				ExpectError: regexp.MustCompile("validation error: use_jwks_url requires a jwks_url"),
+			},
+			{
+				Config: testKeycloakOpenidClient_tlsClientAuthSubjectDn(clientId, "CN=client,O=example"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientAuthenticatorType("keycloak_openid_client.client", "client-x509"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "tls_client_auth_subject_dn", "CN=client,O=example"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "use_jwks_url", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClient_updateInPlace(t *testing.T) {
 	t.Parallel()
 	clientId := acctest.RandomWithPrefix("tf-acc")
@@ -974,6 +1006,72 @@ func TestAccKeycloakOpenidClient_secretRegenerated(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOpenidClient_secretRotated(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{ // the secret is not rotated when the client is created
+				Config: testKeycloakOpenidClient_secretRotation(realmName, clientId, "initial-value", "initial-value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_secret_rotated", ""),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_secret_rotated_expiration_time", "0"),
+				),
+			},
+			{ // the previous secret is kept as rotated secret
+				Config: testKeycloakOpenidClient_secretRotation(realmName, clientId, "second-value", "initial-value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasRotatedSecret("keycloak_openid_client.client"),
+					resource.TestCheckResourceAttrSet("keycloak_openid_client.client", "client_secret_expiration_time"),
+				),
+			},
+			{ // the rotated secret is invalidated before it expires
+				Config: testKeycloakOpenidClient_secretRotation(realmName, clientId, "second-value", "second-value"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_secret_rotated", ""),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "client_secret_rotated_expiration_time", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientHasRotatedSecret(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		rotatedSecret, err := keycloakClient.GetOpenidClientRotatedSecret(testCtx, client.RealmId, client.Id)
+		if err != nil {
+			return err
+		}
+
+		if rotatedSecret == nil {
+			return fmt.Errorf("expected openid client %s to have a rotated secret", client.ClientId)
+		}
+
+		attributes := s.RootModule().Resources[resourceName].Primary.Attributes
+		if attributes["client_secret_rotated"] != rotatedSecret.Value {
+			return fmt.Errorf("expected client_secret_rotated to be the rotated secret of openid client %s", client.ClientId)
+		}
+
+		if attributes["client_secret_rotated"] == attributes["client_secret"] {
+			return fmt.Errorf("expected the rotated secret of openid client %s to differ from its secret", client.ClientId)
+		}
+
+		if attributes["client_secret_rotated_expiration_time"] == "0" {
+			return fmt.Errorf("expected the rotated secret of openid client %s to expire", client.ClientId)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOpenidClientExistsWithCorrectProtocol(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getOpenidClientFromState(s, resourceName)
@@ -1545,6 +1643,59 @@ func testKeycloakOpenidClient_basicWithSecretRegenerate(clientId string, regener
  	`, testAccRealm.Realm, clientId, regenerateValue)
 }
 
+func testKeycloakOpenidClient_secretRotation(realm, clientId, rotateValue, invalidateValue string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "secret_rotation" {
+	realm_id = keycloak_realm.realm.realm
+	name     = "secret-rotation"
+
+	executor {
+		name = "secret-rotation"
+		configuration = {
+			expiration-period         = "2505600"
+			rotated-expiration-period = "172800"
+			remaining-rotation-period = "864000"
+		}
+	}
+}
+
+resource "keycloak_realm_client_policy_profile_policy" "secret_rotation" {
+	realm_id = keycloak_realm.realm.realm
+	name     = "secret-rotation"
+	profiles = [
+		keycloak_realm_client_policy_profile.secret_rotation.name
+	]
+
+	condition {
+		name = "client-access-type"
+		configuration = {
+			is_negative_logic = false
+			type              = jsonencode(["confidential"])
+		}
+	}
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+
+	client_secret_rotate_when_changed = {
+		rotation = "%s"
+	}
+	client_secret_invalidate_rotated_when_changed = {
+		invalidation = "%s"
+	}
+
+	depends_on = [keycloak_realm_client_policy_profile_policy.secret_rotation]
+}
+	`, realm, clientId, rotateValue, invalidateValue)
+}
+
 func testKeycloakOpenidClient_basic_with_consent(clientId string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -1627,6 +1778,39 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, authType)
 }
 
+func testKeycloakOpenidClient_jwksUrl(clientId string, useJwksUrl bool, jwksUrl string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                  = data.keycloak_realm.realm.id
+	client_id                 = "%s"
+	access_type               = "CONFIDENTIAL"
+	client_authenticator_type = "client-jwt"
+	use_jwks_url              = %t
+	jwks_url                  = "%s"
+}
+	`, testAccRealm.Realm, clientId, useJwksUrl, jwksUrl)
+}
+
+func testKeycloakOpenidClient_tlsClientAuthSubjectDn(clientId, subjectDn string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                   = data.keycloak_realm.realm.id
+	client_id                  = "%s"
+	access_type                = "CONFIDENTIAL"
+	client_authenticator_type  = "client-x509"
+	tls_client_auth_subject_dn = "%s"
+}
+	`, testAccRealm.Realm, clientId, subjectDn)
+}
+
 func testKeycloakOpenidClient_pkceChallengeMethod(clientId, pkceChallengeMethod string) string {
 
 	return fmt.Sprintf(`
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenIdOrganizationMembershipProtocolMapperCreate,
+		ReadContext:   resourceKeycloakOpenIdOrganizationMembershipProtocolMapperRead,
+		UpdateContext: resourceKeycloakOpenIdOrganizationMembershipProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakOpenIdOrganizationMembershipProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-friendly name that will appear in the Keycloak console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm id where the associated client or client scope exists.",
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client. Cannot be used at the same time as client_scope_id.",
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client scope. Cannot be used at the same time as client_id.",
+				ConflictsWith: []string{"client_id"},
+			},
+			"add_to_id_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the claim should be added to the id token.",
+			},
+			"add_to_access_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the claim should be added to the access token.",
+			},
+			"add_to_userinfo": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the claim should appear in the userinfo response body.",
+			},
+			"add_to_token_introspection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the claim should be added to the token introspection response body.",
+			},
+			"claim_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "organization",
+				Description: "The name of the claim holding the organizations of the user.",
+			},
+			"claim_value_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "String",
+				Description:  "Claim type used when serializing tokens. Use JSON to add the attributes or the id of the organizations as objects.",
+				ValidateFunc: validation.StringInSlice([]string{"String", "JSON"}, false),
+			},
+			"multivalued": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates if the claim holds all the organizations of the user or only one.",
+			},
+			"add_organization_attributes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the attributes of the organizations should be added to the claim.",
+			},
+			"add_organization_id": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the id of the organizations should be added to the claim.",
+			},
+		},
+	}
+}
+
+func mapFromDataToOpenIdOrganizationMembershipProtocolMapper(data *schema.ResourceData) *keycloak.OpenIdOrganizationMembershipProtocolMapper {
+	return &keycloak.OpenIdOrganizationMembershipProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		AddToIdToken:              data.Get("add_to_id_token").(bool),
+		AddToAccessToken:          data.Get("add_to_access_token").(bool),
+		AddToUserInfo:             data.Get("add_to_userinfo").(bool),
+		AddToTokenIntrospection:   data.Get("add_to_token_introspection").(bool),
+		ClaimName:                 data.Get("claim_name").(string),
+		ClaimValueType:            data.Get("claim_value_type").(string),
+		Multivalued:               data.Get("multivalued").(bool),
+		AddOrganizationAttributes: data.Get("add_organization_attributes").(bool),
+		AddOrganizationId:         data.Get("add_organization_id").(bool),
+	}
+}
+
+func mapFromOpenIdOrganizationMembershipProtocolMapperToData(mapper *keycloak.OpenIdOrganizationMembershipProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("add_to_id_token", mapper.AddToIdToken)
+	data.Set("add_to_access_token", mapper.AddToAccessToken)
+	data.Set("add_to_userinfo", mapper.AddToUserInfo)
+	data.Set("add_to_token_introspection", mapper.AddToTokenIntrospection)
+	data.Set("claim_name", mapper.ClaimName)
+	data.Set("claim_value_type", mapper.ClaimValueType)
+	data.Set("multivalued", mapper.Multivalued)
+	data.Set("add_organization_attributes", mapper.AddOrganizationAttributes)
+	data.Set("add_organization_id", mapper.AddOrganizationId)
+}
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdOrganizationMembershipMapper := mapFromDataToOpenIdOrganizationMembershipProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdOrganizationMembershipProtocolMapper(ctx, openIdOrganizationMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewOpenIdOrganizationMembershipProtocolMapper(ctx, openIdOrganizationMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromOpenIdOrganizationMembershipProtocolMapperToData(openIdOrganizationMembershipMapper, data)
+
+	return resourceKeycloakOpenIdOrganizationMembershipProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	openIdOrganizationMembershipMapper, err := keycloakClient.GetOpenIdOrganizationMembershipProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOpenIdOrganizationMembershipProtocolMapperToData(openIdOrganizationMembershipMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdOrganizationMembershipMapper := mapFromDataToOpenIdOrganizationMembershipProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdOrganizationMembershipProtocolMapper(ctx, openIdOrganizationMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateOpenIdOrganizationMembershipProtocolMapper(ctx, openIdOrganizationMembershipMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOpenIdOrganizationMembershipProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdOrganizationMembershipProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteOpenIdOrganizationMembershipProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
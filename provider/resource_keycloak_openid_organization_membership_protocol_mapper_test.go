@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdOrganizationMembershipProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_organization_membership_protocol_mapper.organization_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_organization_id", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdOrganizationMembershipProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_organization_membership_protocol_mapper.organization_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_clientScope(clientScopeName, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_organization_id", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdOrganizationMembershipProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_organization_membership_protocol_mapper.organization_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdOrganizationMembershipProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+	updatedValue := "false"
+
+	resourceName := "keycloak_openid_organization_membership_protocol_mapper.organization_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_organization_id", value),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_client(clientId, mapperName, updatedValue),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_organization_id", updatedValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdOrganizationMembershipProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.OpenIdOrganizationMembershipProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := "true"
+
+	resourceName := "keycloak_openid_organization_membership_protocol_mapper.organization_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdOrganizationMembershipProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteOpenIdOrganizationMembershipProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakOpenIdOrganizationMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+func testAccKeycloakOpenIdOrganizationMembershipProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_organization_membership_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getOpenIdOrganizationMembershipMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid organization membership protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdOrganizationMembershipProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getOpenIdOrganizationMembershipMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdOrganizationMembershipProtocolMapperFetch(resourceName string, mapper *keycloak.OpenIdOrganizationMembershipProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getOpenIdOrganizationMembershipMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getOpenIdOrganizationMembershipMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdOrganizationMembershipProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdOrganizationMembershipProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdOrganizationMembershipProtocolMapper_client(clientId, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type = "BEARER-ONLY"
+}
+
+resource "keycloak_openid_organization_membership_protocol_mapper" "organization_membership_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	claim_value_type    = "JSON"
+	add_organization_id = %s
+}`, testAccRealm.Realm, clientId, mapperName, value)
+}
+
+func testKeycloakOpenIdOrganizationMembershipProtocolMapper_clientScope(clientScopeName, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_openid_organization_membership_protocol_mapper" "organization_membership_mapper" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+
+	claim_value_type    = "JSON"
+	add_organization_id = %s
+}`, testAccRealm.Realm, clientScopeName, mapperName, value)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenIdRoleNameProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenIdRoleNameProtocolMapperCreate,
+		ReadContext:   resourceKeycloakOpenIdRoleNameProtocolMapperRead,
+		UpdateContext: resourceKeycloakOpenIdRoleNameProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakOpenIdRoleNameProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-friendly name that will appear in the Keycloak console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm id where the associated client or client scope exists.",
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client. Cannot be used at the same time as client_scope_id.",
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client scope. Cannot be used at the same time as client_id.",
+				ConflictsWith: []string{"client_id"},
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The id of the role to rename.",
+			},
+			"new_role_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The new name of the role. A name of the form client_id.role_name maps it to a role of that client.",
+			},
+		},
+	}
+}
+
+func mapFromDataToOpenIdRoleNameProtocolMapper(data *schema.ResourceData) *keycloak.OpenIdRoleNameProtocolMapper {
+	return &keycloak.OpenIdRoleNameProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		RoleId:      data.Get("role_id").(string),
+		NewRoleName: data.Get("new_role_name").(string),
+	}
+}
+
+func mapFromOpenIdRoleNameProtocolMapperToData(mapper *keycloak.OpenIdRoleNameProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("role_id", mapper.RoleId)
+	data.Set("new_role_name", mapper.NewRoleName)
+}
+
+func resourceKeycloakOpenIdRoleNameProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdRoleNameMapper := mapFromDataToOpenIdRoleNameProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdRoleNameProtocolMapper(ctx, openIdRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewOpenIdRoleNameProtocolMapper(ctx, openIdRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromOpenIdRoleNameProtocolMapperToData(openIdRoleNameMapper, data)
+
+	return resourceKeycloakOpenIdRoleNameProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdRoleNameProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	openIdRoleNameMapper, err := keycloakClient.GetOpenIdRoleNameProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOpenIdRoleNameProtocolMapperToData(openIdRoleNameMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakOpenIdRoleNameProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdRoleNameMapper := mapFromDataToOpenIdRoleNameProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdRoleNameProtocolMapper(ctx, openIdRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateOpenIdRoleNameProtocolMapper(ctx, openIdRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOpenIdRoleNameProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdRoleNameProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteOpenIdRoleNameProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOpenIdSha256PairwiseSubProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperCreate,
+		ReadContext:   resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperRead,
+		UpdateContext: resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-friendly name that will appear in the Keycloak console.",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm id where the associated client or client scope exists.",
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client. Cannot be used at the same time as client_scope_id.",
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The mapper's associated client scope. Cannot be used at the same time as client_id.",
+				ConflictsWith: []string{"client_id"},
+			},
+			"sector_identifier_uri": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL of a JSON document listing the redirect URIs of the clients sharing the pairwise subject identifiers. Required when the redirect URIs of the client have several hosts.",
+			},
+			"salt": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The salt of the pairwise subject identifiers. Generated by Keycloak when not set.",
+			},
+		},
+	}
+}
+
+func mapFromDataToOpenIdSha256PairwiseSubProtocolMapper(data *schema.ResourceData) *keycloak.OpenIdSha256PairwiseSubProtocolMapper {
+	return &keycloak.OpenIdSha256PairwiseSubProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		SectorIdentifierUri:      data.Get("sector_identifier_uri").(string),
+		PairwiseSubAlgorithmSalt: data.Get("salt").(string),
+	}
+}
+
+func mapFromOpenIdSha256PairwiseSubProtocolMapperToData(mapper *keycloak.OpenIdSha256PairwiseSubProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("sector_identifier_uri", mapper.SectorIdentifierUri)
+	data.Set("salt", mapper.PairwiseSubAlgorithmSalt)
+}
+
+func resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdSha256PairwiseSubMapper := mapFromDataToOpenIdSha256PairwiseSubProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdSha256PairwiseSubProtocolMapper(ctx, openIdSha256PairwiseSubMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewOpenIdSha256PairwiseSubProtocolMapper(ctx, openIdSha256PairwiseSubMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromOpenIdSha256PairwiseSubProtocolMapperToData(openIdSha256PairwiseSubMapper, data)
+
+	return resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	openIdSha256PairwiseSubMapper, err := keycloakClient.GetOpenIdSha256PairwiseSubProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromOpenIdSha256PairwiseSubProtocolMapperToData(openIdSha256PairwiseSubMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	openIdSha256PairwiseSubMapper := mapFromDataToOpenIdSha256PairwiseSubProtocolMapper(data)
+
+	err := keycloakClient.ValidateOpenIdSha256PairwiseSubProtocolMapper(ctx, openIdSha256PairwiseSubMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateOpenIdSha256PairwiseSubProtocolMapper(ctx, openIdSha256PairwiseSubMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakOpenIdSha256PairwiseSubProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteOpenIdSha256PairwiseSubProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
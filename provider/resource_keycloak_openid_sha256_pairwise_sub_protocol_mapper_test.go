@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOpenIdSha256PairwiseSubProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandString(16)
+
+	resourceName := "keycloak_openid_sha256_pairwise_sub_protocol_mapper.sha256_pairwise_sub_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdSha256PairwiseSubProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdSha256PairwiseSubProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdSha256PairwiseSubProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "salt", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdSha256PairwiseSubProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandString(16)
+
+	resourceName := "keycloak_openid_sha256_pairwise_sub_protocol_mapper.sha256_pairwise_sub_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdSha256PairwiseSubProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdSha256PairwiseSubProtocolMapper_clientScope(clientScopeName, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdSha256PairwiseSubProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "salt", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdSha256PairwiseSubProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandString(16)
+
+	resourceName := "keycloak_openid_sha256_pairwise_sub_protocol_mapper.sha256_pairwise_sub_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdSha256PairwiseSubProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdSha256PairwiseSubProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdSha256PairwiseSubProtocolMapperExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdSha256PairwiseSubProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandString(16)
+	updatedValue := acctest.RandString(16)
+
+	resourceName := "keycloak_openid_sha256_pairwise_sub_protocol_mapper.sha256_pairwise_sub_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdSha256PairwiseSubProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdSha256PairwiseSubProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdSha256PairwiseSubProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "salt", value),
+				),
+			},
+			{
+				Config: testKeycloakOpenIdSha256PairwiseSubProtocolMapper_client(clientId, mapperName, updatedValue),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakOpenIdSha256PairwiseSubProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "salt", updatedValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdSha256PairwiseSubProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.OpenIdSha256PairwiseSubProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandString(16)
+
+	resourceName := "keycloak_openid_sha256_pairwise_sub_protocol_mapper.sha256_pairwise_sub_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdSha256PairwiseSubProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenIdSha256PairwiseSubProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdSha256PairwiseSubProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteOpenIdSha256PairwiseSubProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakOpenIdSha256PairwiseSubProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakOpenIdSha256PairwiseSubProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenIdSha256PairwiseSubProtocolMapper_validateRedirectUriHosts(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakOpenIdSha256PairwiseSubProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenIdSha256PairwiseSubProtocolMapper_sectorIdentifierUri(clientId, mapperName, ""),
+				ExpectError: regexp.MustCompile("validation error: the redirect URIs of client .+ have several hosts, SectorIdentifierUri must be set"),
+			},
+			{
+				Config:      testKeycloakOpenIdSha256PairwiseSubProtocolMapper_sectorIdentifierUri(clientId, mapperName, "sector.json"),
+				ExpectError: regexp.MustCompile("validation error: SectorIdentifierUri sector.json must be an absolute URL"),
+			},
+		},
+	})
+}
+
+func testAccKeycloakOpenIdSha256PairwiseSubProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_openid_sha256_pairwise_sub_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getOpenIdSha256PairwiseSubMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("openid sha256 pairwise sub protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdSha256PairwiseSubProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getOpenIdSha256PairwiseSubMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenIdSha256PairwiseSubProtocolMapperFetch(resourceName string, mapper *keycloak.OpenIdSha256PairwiseSubProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getOpenIdSha256PairwiseSubMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getOpenIdSha256PairwiseSubMapperUsingState(state *terraform.State, resourceName string) (*keycloak.OpenIdSha256PairwiseSubProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetOpenIdSha256PairwiseSubProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakOpenIdSha256PairwiseSubProtocolMapper_client(clientId, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type           = "CONFIDENTIAL"
+	standard_flow_enabled = true
+	valid_redirect_uris   = ["https://app.example.com/callback", "https://app.example.com/other/*"]
+}
+
+resource "keycloak_openid_sha256_pairwise_sub_protocol_mapper" "sha256_pairwise_sub_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	salt = "%s"
+}`, testAccRealm.Realm, clientId, mapperName, value)
+}
+
+func testKeycloakOpenIdSha256PairwiseSubProtocolMapper_clientScope(clientScopeName, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client_scope" "client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_openid_sha256_pairwise_sub_protocol_mapper" "sha256_pairwise_sub_mapper" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_openid_client_scope.client_scope.id
+
+	salt = "%s"
+}`, testAccRealm.Realm, clientScopeName, mapperName, value)
+}
+
+func testKeycloakOpenIdSha256PairwiseSubProtocolMapper_sectorIdentifierUri(clientId, mapperName, sectorIdentifierUri string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "openid_client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+
+	access_type           = "CONFIDENTIAL"
+	standard_flow_enabled = true
+	valid_redirect_uris   = ["https://app.example.com/callback", "https://other.example.com/callback"]
+}
+
+resource "keycloak_openid_sha256_pairwise_sub_protocol_mapper" "sha256_pairwise_sub_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.openid_client.id
+
+	sector_identifier_uri = "%s"
+}`, testAccRealm.Realm, clientId, mapperName, sectorIdentifierUri)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"dario.cat/mergo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
@@ -77,6 +78,16 @@ func resourceKeycloakOrganization() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"import": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"keep_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -179,8 +190,23 @@ func resourceKeycloakOrganizationCreate(ctx context.Context, data *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	if err = keycloakClient.NewOrganization(ctx, Organization); err != nil {
-		return diag.FromErr(err)
+	if !data.Get("import").(bool) {
+		if err = keycloakClient.NewOrganization(ctx, Organization); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		existingOrganization, err := keycloakClient.GetOrganizationByName(ctx, Organization.Realm, Organization.Name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = mergo.Merge(Organization, existingOrganization); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = keycloakClient.UpdateOrganization(ctx, Organization); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	if err = setOrganizationData(data, Organization); err != nil {
 		return diag.FromErr(err)
@@ -197,6 +223,10 @@ func resourceKeycloakOrganizationRead(ctx context.Context, data *schema.Resource
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	if _, ok := data.GetOk("import"); !ok {
+		data.Set("import", false)
+	}
+
 	return diag.FromErr(setOrganizationData(data, Organization))
 }
 
@@ -216,6 +246,10 @@ func resourceKeycloakOrganizationUpdate(ctx context.Context, data *schema.Resour
 }
 
 func resourceKeycloakOrganizationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if keepOnDestroy(data.GetRawState()) {
+		return nil
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realm := data.Get("realm").(string)
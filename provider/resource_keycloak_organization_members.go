@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOrganizationMembersReconcile,
+		ReadContext:   resourceKeycloakOrganizationMembersRead,
+		DeleteContext: resourceKeycloakOrganizationMembersDelete,
+		UpdateContext: resourceKeycloakOrganizationMembersReconcile,
+		// This resource can be imported using {{realm}}/{{organizationId}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakOrganizationMembersImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Realm ID.",
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+				Optional: true,
+			},
+			"exhaustive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When true, the unmanaged members that are not listed in user_ids are removed from the organization.",
+			},
+			"invitation": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "People without an account who are invited by email to register and join the organization.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All the members of the organization, including the ones not managed by this resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"membership_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getOrganizationInvitationsFromData(invitations *schema.Set) []*keycloak.OrganizationInvitation {
+	var organizationInvitations []*keycloak.OrganizationInvitation
+
+	for _, invitation := range invitations.List() {
+		invitationMap := invitation.(map[string]interface{})
+
+		organizationInvitations = append(organizationInvitations, &keycloak.OrganizationInvitation{
+			Email:     invitationMap["email"].(string),
+			FirstName: invitationMap["first_name"].(string),
+			LastName:  invitationMap["last_name"].(string),
+		})
+	}
+
+	return organizationInvitations
+}
+
+// invitedEmails returns the emails of the invitations, members who registered after being invited are matched by email
+func invitedEmails(invitations *schema.Set) map[string]bool {
+	emails := map[string]bool{}
+	for _, invitation := range getOrganizationInvitationsFromData(invitations) {
+		emails[strings.ToLower(invitation.Email)] = true
+	}
+
+	return emails
+}
+
+// isManagedByOrganizationMembers tells whether the membership of a member is the concern of this resource. Managed
+// members belong to the identity providers of the organization, removing them would delete their account.
+func isManagedByOrganizationMembers(member *keycloak.OrganizationMember, userIds *schema.Set, invitations *schema.Set, exhaustive bool) bool {
+	if userIds.Contains(member.Id) {
+		return true
+	}
+
+	return exhaustive && member.MembershipType != keycloak.OrganizationMembershipTypeManaged && !invitedEmails(invitations)[strings.ToLower(member.Email)]
+}
+
+func resourceKeycloakOrganizationMembersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+	organizationId := data.Get("organization_id").(string)
+	userIds := data.Get("user_ids").(*schema.Set)
+	invitations := data.Get("invitation").(*schema.Set)
+	exhaustive := data.Get("exhaustive").(bool)
+
+	organizationMembers, err := keycloakClient.GetOrganizationMembers(ctx, realm, organizationId, &keycloak.OrganizationMembersQuery{})
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	var memberIds []string
+	var members []interface{}
+	for _, member := range organizationMembers {
+		if isManagedByOrganizationMembers(member, userIds, invitations, exhaustive) {
+			memberIds = append(memberIds, member.Id)
+		}
+
+		members = append(members, map[string]interface{}{
+			"user_id":         member.Id,
+			"username":        member.Username,
+			"email":           member.Email,
+			"membership_type": member.MembershipType,
+		})
+	}
+
+	data.Set("user_ids", memberIds)
+	data.Set("members", members)
+	data.SetId(organizationMembersId(realm, organizationId))
+
+	return nil
+}
+
+func resourceKeycloakOrganizationMembersReconcile(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+	organizationId := data.Get("organization_id").(string)
+	userIds := data.Get("user_ids").(*schema.Set)
+	invitations := data.Get("invitation").(*schema.Set)
+	exhaustive := data.Get("exhaustive").(bool)
+
+	organizationMembers, err := keycloakClient.GetOrganizationMembers(ctx, realm, organizationId, &keycloak.OrganizationMembersQuery{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	currentMembers := map[string]*keycloak.OrganizationMember{}
+	memberEmails := map[string]bool{}
+	for _, member := range organizationMembers {
+		currentMembers[member.Id] = member
+		memberEmails[strings.ToLower(member.Email)] = true
+	}
+
+	var remove []string
+	if data.HasChange("user_ids") {
+		o, _ := data.GetChange("user_ids")
+		remove = interfaceSliceToStringSlice(o.(*schema.Set).Difference(userIds).List())
+	}
+	if exhaustive {
+		for _, member := range organizationMembers {
+			if !userIds.Contains(member.Id) && isManagedByOrganizationMembers(member, userIds, invitations, exhaustive) {
+				remove = append(remove, member.Id)
+			}
+		}
+	}
+
+	for _, userId := range remove {
+		member, ok := currentMembers[userId]
+		if !ok || member.MembershipType == keycloak.OrganizationMembershipTypeManaged {
+			continue
+		}
+
+		if err := keycloakClient.RemoveOrganizationMember(ctx, realm, organizationId, userId); err != nil {
+			return diag.FromErr(err)
+		}
+		delete(currentMembers, userId)
+	}
+
+	for _, userId := range interfaceSliceToStringSlice(userIds.List()) {
+		if _, ok := currentMembers[userId]; ok {
+			continue
+		}
+
+		if err := keycloakClient.AddOrganizationMember(ctx, realm, organizationId, userId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// invitations are only sent once, when they are added
+	newInvitations := invitations
+	if !data.IsNewResource() {
+		o, _ := data.GetChange("invitation")
+		newInvitations = invitations.Difference(o.(*schema.Set))
+	}
+	for _, invitation := range getOrganizationInvitationsFromData(newInvitations) {
+		if memberEmails[strings.ToLower(invitation.Email)] {
+			continue
+		}
+
+		if err := keycloakClient.InviteOrganizationMember(ctx, realm, organizationId, invitation); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(organizationMembersId(realm, organizationId))
+
+	return resourceKeycloakOrganizationMembersRead(ctx, data, meta)
+}
+
+func resourceKeycloakOrganizationMembersDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm := data.Get("realm").(string)
+	organizationId := data.Get("organization_id").(string)
+	userIds := data.Get("user_ids").(*schema.Set)
+
+	organizationMembers, err := keycloakClient.GetOrganizationMembers(ctx, realm, organizationId, &keycloak.OrganizationMembersQuery{})
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	for _, member := range organizationMembers {
+		if !userIds.Contains(member.Id) || member.MembershipType == keycloak.OrganizationMembershipTypeManaged {
+			continue
+		}
+
+		if err := keycloakClient.RemoveOrganizationMember(ctx, realm, organizationId, member.Id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakOrganizationMembersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{organizationId}}.")
+	}
+
+	realm := parts[0]
+	organizationId := parts[1]
+
+	_, err := keycloakClient.GetOrganization(ctx, realm, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm", realm)
+	d.Set("organization_id", organizationId)
+	d.Set("exhaustive", true)
+
+	diagnostics := resourceKeycloakOrganizationMembersRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func organizationMembersId(realm, organizationId string) string {
+	return fmt.Sprintf("%s/%s", realm, organizationId)
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakOrganizationMembers_basic(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26)
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganizationMembers_basic(organizationName, "keycloak_user.user_one.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOrganizationMembersCount("keycloak_organization_members.members", 1),
+					resource.TestCheckResourceAttr("keycloak_organization_members.members", "members.0.membership_type", keycloak.OrganizationMembershipTypeUnmanaged),
+				),
+			},
+			{
+				Config: testKeycloakOrganizationMembers_basic(organizationName, "keycloak_user.user_one.id", "keycloak_user.user_two.id"),
+				Check:  testAccCheckKeycloakOrganizationMembersCount("keycloak_organization_members.members", 2),
+			},
+			{
+				Config: testKeycloakOrganizationMembers_basic(organizationName, "keycloak_user.user_two.id"),
+				Check:  testAccCheckKeycloakOrganizationMembersCount("keycloak_organization_members.members", 1),
+			},
+			{
+				ResourceName:      "keycloak_organization_members.members",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOrganizationMembers_exhaustive(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26)
+	organizationName := acctest.RandomWithPrefix("tf-acc")
+
+	addUnmanagedMember := func() {
+		organization, err := keycloakClient.GetOrganizationByName(testCtx, testAccRealm.Realm, organizationName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, organizationName+"-two")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := keycloakClient.AddOrganizationMember(testCtx, testAccRealm.Realm, organization.Id, user.Id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOrganizationMembers_exhaustive(organizationName, false),
+				Check:  testAccCheckKeycloakOrganizationMembersCount("keycloak_organization_members.members", 1),
+			},
+			{
+				PreConfig: addUnmanagedMember,
+				Config:    testKeycloakOrganizationMembers_exhaustive(organizationName, false),
+				Check:     testAccCheckKeycloakOrganizationMembersCount("keycloak_organization_members.members", 2),
+			},
+			{
+				Config: testKeycloakOrganizationMembers_exhaustive(organizationName, true),
+				Check:  testAccCheckKeycloakOrganizationMembersCount("keycloak_organization_members.members", 1),
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakOrganizationMembersCount checks the number of members of the organization in Keycloak
+func testAccCheckKeycloakOrganizationMembersCount(resourceName string, count int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm := rs.Primary.Attributes["realm"]
+		organizationId := rs.Primary.Attributes["organization_id"]
+
+		members, err := keycloakClient.GetOrganizationMembers(testCtx, realm, organizationId, &keycloak.OrganizationMembersQuery{})
+		if err != nil {
+			return err
+		}
+
+		if len(members) != count {
+			return fmt.Errorf("expected organization %s to have %d members, got %d", organizationId, count, len(members))
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOrganizationMembers_users(organizationName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_organization" "organization" {
+	name  = "%s"
+	realm = data.keycloak_realm.realm.id
+
+	domain {
+		name = "%s.example.com"
+	}
+}
+
+resource "keycloak_user" "user_one" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-one"
+	email    = "%s-one@example.com"
+}
+
+resource "keycloak_user" "user_two" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-two"
+	email    = "%s-two@example.com"
+}
+	`, testAccRealm.Realm, organizationName, organizationName, organizationName, organizationName, organizationName, organizationName)
+}
+
+func testKeycloakOrganizationMembers_basic(organizationName string, userIds ...string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_organization_members" "members" {
+	realm           = data.keycloak_realm.realm.id
+	organization_id = keycloak_organization.organization.id
+
+	user_ids = [%s]
+}
+	`, testKeycloakOrganizationMembers_users(organizationName), strings.Join(userIds, ", "))
+}
+
+func testKeycloakOrganizationMembers_exhaustive(organizationName string, exhaustive bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_organization_members" "members" {
+	realm           = data.keycloak_realm.realm.id
+	organization_id = keycloak_organization.organization.id
+	exhaustive      = %t
+
+	user_ids = [keycloak_user.user_one.id]
+}
+	`, testKeycloakOrganizationMembers_users(organizationName), exhaustive)
+}
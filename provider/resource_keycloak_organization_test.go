@@ -161,6 +161,58 @@ func TestAccKeycloakOrganization_basicWithAttributes(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakOrganization_adopt(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26)
+
+	existingOrganization := &keycloak.Organization{
+		Realm:   testAccRealm.Realm,
+		Name:    acctest.RandomWithPrefix("tf-acc"),
+		Enabled: true,
+		Domains: []keycloak.OrganizationDomain{
+			{Name: acctest.RandomWithPrefix("tf-acc") + ".com"},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakOrganizationNotDestroyed(),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					err := keycloakClient.NewOrganization(testCtx, existingOrganization)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					t.Cleanup(func() {
+						keycloakClient.DeleteOrganization(testCtx, existingOrganization.Realm, existingOrganization.Id)
+					})
+				},
+				// the description of the adopted organization is updated
+				Config: testKeycloakOrganization_adopt(existingOrganization.Name, existingOrganization.Domains[0].Name, "adopted by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("keycloak_organization.organization", "id", &existingOrganization.Id),
+					resource.TestCheckResourceAttr("keycloak_organization.organization", "description", "adopted by terraform"),
+					testAccCheckKeycloakOrganizationHasDomains("keycloak_organization.organization", existingOrganization.Domains[0].Name),
+					func(s *terraform.State) error {
+						organization, err := getOrganizationFromState(s, "keycloak_organization.organization")
+						if err != nil {
+							return err
+						}
+
+						if organization.Description != "adopted by terraform" {
+							return fmt.Errorf("expected organization %s to have description %s, but got %s", organization.Name, "adopted by terraform", organization.Description)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakOrganizationExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getOrganizationFromState(s, resourceName)
@@ -192,6 +244,27 @@ func testAccCheckKeycloakOrganizationDestroy() resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckKeycloakOrganizationNotDestroyed checks that adopted organizations are left in place
+func testAccCheckKeycloakOrganizationNotDestroyed() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_organization" || strings.HasPrefix(name, "data") {
+				continue
+			}
+
+			id := rs.Primary.ID
+			realm := rs.Primary.Attributes["realm"]
+
+			organization, _ := keycloakClient.GetOrganization(testCtx, realm, id)
+			if organization == nil {
+				return fmt.Errorf("%s with id %s does not exist", name, id)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOrganizationFetch(resourceName string, organization *keycloak.Organization) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		fetchedOrganization, err := getOrganizationFromState(state, resourceName)
@@ -336,3 +409,22 @@ resource "keycloak_organization" "organization" {
 }
 	`, testAccRealm.Realm, organizationName, attributeName, attributeValue)
 }
+
+func testKeycloakOrganization_adopt(organizationName, domainName, description string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_organization" "organization" {
+	name        = "%s"
+	realm       = data.keycloak_realm.realm.id
+	description = "%s"
+	import      = true
+
+	domain {
+		name = "%s"
+	}
+}
+	`, testAccRealm.Realm, organizationName, description, domainName)
+}
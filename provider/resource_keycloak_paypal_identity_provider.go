@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKeycloakPaypalIdentityProvider() *schema.Resource {
+	return resourceKeycloakSocialIdentityProvider(&socialIdentityProvider{
+		providerId: "paypal",
+		schema: map[string]*schema.Schema{
+			"sandbox": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Target the PayPal sandbox environment instead of the live one.",
+			},
+		},
+		configKeys: map[string]string{
+			"sandbox": "sandbox",
+		},
+	})
+}
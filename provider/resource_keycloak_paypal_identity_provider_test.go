@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakPaypalIdentityProvider_sandbox(t *testing.T) {
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_paypal_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_paypal_identity_provider", alias, "sandbox = true"),
+				Check:  testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_paypal_identity_provider.social", "sandbox", "true"),
+			},
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_paypal_identity_provider", alias, "sandbox = false"),
+				Check:  testAccCheckKeycloakSocialIdentityProviderHasConfigValue("keycloak_paypal_identity_provider.social", "sandbox", "false"),
+			},
+		},
+	})
+}
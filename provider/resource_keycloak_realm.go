@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -193,6 +194,12 @@ func resourceKeycloakRealm() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"admin_permissions_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables fine-grained admin permissions v2, managed with keycloak_admin_permission. Requires Keycloak 26.2 or later.",
+			},
 
 			// Login Config
 			"registration_allowed": {
@@ -1045,6 +1052,12 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 
 	setRealmFlowBindings(data, realm, keycloakVersion)
 
+	if keycloakVersion.GreaterThanOrEqual(keycloak.Version_26_2.AsVersion()) {
+		realm.AdminPermissionsEnabled = boolPointer(data.Get("admin_permissions_enabled").(bool))
+	} else if data.Get("admin_permissions_enabled").(bool) {
+		return nil, fmt.Errorf("admin_permissions_enabled requires Keycloak 26.2 or later, use keycloak_users_permissions, keycloak_group_permissions and keycloak_openid_client_permissions on older versions")
+	}
+
 	attributes := map[string]interface{}{}
 	if v, ok := data.GetOk("attributes"); ok {
 		for key, value := range v.(map[string]interface{}) {
@@ -1219,6 +1232,7 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 	data.Set("display_name_html", realm.DisplayNameHtml)
 	data.Set("user_managed_access", realm.UserManagedAccess)
 	data.Set("organizations_enabled", realm.OrganizationsEnabled)
+	data.Set("admin_permissions_enabled", realm.AdminPermissionsEnabled != nil && *realm.AdminPermissionsEnabled)
 
 	// Login Config
 	data.Set("registration_allowed", realm.RegistrationAllowed)
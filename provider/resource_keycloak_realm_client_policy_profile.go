@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +16,9 @@ func resourceKeycloakRealmClientPolicyProfile() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmClientPolicyProfileRead,
 		DeleteContext: resourceKeycloakRealmClientPolicyProfileDelete,
 		UpdateContext: resourceKeycloakRealmClientPolicyProfileUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmClientPolicyProfileImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -184,3 +188,16 @@ func mapFromRealmClientPolicyProfileToData(data *schema.ResourceData, profile *k
 
 	return nil
 }
+
+func resourceKeycloakRealmClientPolicyProfileImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{name}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("name", parts[1])
+	d.SetId(fmt.Sprintf("%s/realm-client-policy-profiles/%s", parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
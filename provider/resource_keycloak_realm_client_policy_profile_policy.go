@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +16,9 @@ func resourceKeycloakRealmClientPolicyProfilePolicy() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmClientPolicyProfilePolicyRead,
 		DeleteContext: resourceKeycloakRealmClientPolicyProfilePolicyDelete,
 		UpdateContext: resourceKeycloakRealmClientPolicyProfilePolicyUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmClientPolicyProfilePolicyImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -219,3 +223,16 @@ func mapFromRealmClientPolicyProfilePolicyToData(data *schema.ResourceData, poli
 
 	return nil
 }
+
+func resourceKeycloakRealmClientPolicyProfilePolicyImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{name}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("name", parts[1])
+	d.SetId(fmt.Sprintf("%s/realm-client-policy-profile-policies/%s", parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
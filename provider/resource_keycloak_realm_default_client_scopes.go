@@ -13,6 +13,9 @@ func resourceKeycloakRealmDefaultClientScopes() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmDefaultClientScopesRead,
 		DeleteContext: resourceKeycloakRealmDefaultClientScopesDelete,
 		UpdateContext: resourceKeycloakRealmDefaultClientScopesReconcile,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmDefaultClientScopesImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -97,3 +100,9 @@ func resourceKeycloakRealmDefaultClientScopesDelete(ctx context.Context, data *s
 
 	return diag.FromErr(keycloakClient.UnmarkClientScopesAsRealmDefault(ctx, realmId, interfaceSliceToStringSlice(defaultClientScopes.List())))
 }
+
+func resourceKeycloakRealmDefaultClientScopesImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
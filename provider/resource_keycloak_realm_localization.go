@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,10 @@ func resourceKeycloakRealmLocalization() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmLocalizationTextsRead,
 		DeleteContext: resourceKeycloakRealmLocalizationTextsDelete,
 		UpdateContext: resourceKeycloakRealmLocalizationTextsUpdate,
-		Description:   "Manage realm-level localization texts.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmLocalizationTextsImport,
+		},
+		Description: "Manage realm-level localization texts.",
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:        schema.TypeString,
@@ -97,3 +101,15 @@ func convertTexts(texts map[string]interface{}) map[string]string {
 
 	return translionsConverted
 }
+
+func resourceKeycloakRealmLocalizationTextsImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{locale}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("locale", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
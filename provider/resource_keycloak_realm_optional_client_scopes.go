@@ -13,6 +13,9 @@ func resourceKeycloakRealmOptionalClientScopes() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmOptionalClientScopesRead,
 		DeleteContext: resourceKeycloakRealmOptionalClientScopesDelete,
 		UpdateContext: resourceKeycloakRealmOptionalClientScopesReconcile,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmOptionalClientScopesImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -97,3 +100,9 @@ func resourceKeycloakRealmOptionalClientScopesDelete(ctx context.Context, data *
 
 	return diag.FromErr(keycloakClient.UnmarkClientScopesAsRealmOptional(ctx, realmId, interfaceSliceToStringSlice(optionalClientScopes.List())))
 }
+
+func resourceKeycloakRealmOptionalClientScopesImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakSamlAdvancedRoleIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attributes": identityProviderMapperKeyValuesSchema("Attributes which must all be present in the assertion, the value of an attribute may be a regex when attribute_values_regex is true"),
+		"attribute_values_regex": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the values of the attributes are regexes",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Role Name",
+		},
+		"sync_mode":    identityProviderMapperSyncModeSchema(),
+		"extra_config": identityProviderMapperExtraConfigSchema(advancedIdentityProviderMapperAttributesKey, advancedIdentityProviderMapperAttributeValuesRegexKey),
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getSamlAdvancedRoleIdentityProviderMapperFromData, setSamlAdvancedRoleIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setSamlAdvancedRoleIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getSamlAdvancedRoleIdentityProviderMapperFromData, setSamlAdvancedRoleIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getSamlAdvancedRoleIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rec, _ := getIdentityProviderMapperFromData(data)
+	_, err := getIdentityProviderMapperIdentityProvider(ctx, keycloakClient, "keycloak_saml_advanced_role_identity_provider_mapper", rec, isSamlIdentityProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "saml-advanced-role-idp-mapper"
+	rec.Config.Role = data.Get("role").(string)
+	rec.Config.ExtraConfig[advancedIdentityProviderMapperAttributeValuesRegexKey] = strconv.FormatBool(data.Get("attribute_values_regex").(bool))
+	getIdentityProviderMapperSyncModeFromData(data, rec)
+
+	err = rec.Config.SetKeyValues(advancedIdentityProviderMapperAttributesKey, getIdentityProviderMapperKeyValuesFromData(data, "attributes"))
+	if err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+func setSamlAdvancedRoleIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	attributes, err := identityProviderMapper.Config.GetKeyValues(advancedIdentityProviderMapperAttributesKey)
+	if err != nil {
+		return err
+	}
+
+	attributeValuesRegex, err := parseIdentityProviderMapperBool(identityProviderMapper, advancedIdentityProviderMapperAttributeValuesRegexKey)
+	if err != nil {
+		return err
+	}
+
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	setIdentityProviderMapperSyncModeData(data, identityProviderMapper)
+	setIdentityProviderMapperKeyValuesData(data, "attributes", attributes)
+	data.Set("attribute_values_regex", attributeValuesRegex)
+	data.Set("role", identityProviderMapper.Config.Role)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakSamlAdvancedRoleIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_saml_advanced_role_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_saml_advanced_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlAdvancedRoleIdentityProviderMapper_basic(alias, mapperName, roleName, "admins", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.key", "memberOf"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.value", "admins"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_values_regex", "false"),
+					resource.TestCheckResourceAttr(resourceName, "role", roleName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlAdvancedRoleIdentityProviderMapper_update(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_saml_advanced_role_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_saml_advanced_role_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlAdvancedRoleIdentityProviderMapper_basic(alias, mapperName, roleName, "admins", false),
+				Check:  resource.TestCheckResourceAttr(resourceName, "attributes.0.value", "admins"),
+			},
+			{
+				Config: testKeycloakSamlAdvancedRoleIdentityProviderMapper_basic(alias, mapperName, roleName, "admin.*", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.value", "admin.*"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_values_regex", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakSamlAdvancedRoleIdentityProviderMapper_basic(alias, name, roleName, memberOfAttributeValue string, attributeValuesRegex bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_advanced_role_identity_provider_mapper" "mapper" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	role                    = keycloak_role.role.name
+
+	attributes {
+		key   = "memberOf"
+		value = "%s"
+	}
+
+	attributes {
+		key   = "department"
+		value = "it"
+	}
+
+	attribute_values_regex = %t
+}
+	`, testAccRealm.Realm, alias, roleName, name, memberOfAttributeValue, attributeValuesRegex)
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakSamlAudienceProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakSamlAudienceProtocolMapperCreate,
+		ReadContext:   resourceKeycloakSamlAudienceProtocolMapperRead,
+		UpdateContext: resourceKeycloakSamlAudienceProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakSamlAudienceProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_id"},
+			},
+			"included_client_audience": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "A client ID to include within the assertion's audience. Cannot be used with included_custom_audience.",
+				ConflictsWith: []string{"included_custom_audience"},
+			},
+			"included_custom_audience": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "A custom audience to include within the assertion's audience. Cannot be used with included_client_audience.",
+				ConflictsWith: []string{"included_client_audience"},
+			},
+		},
+	}
+}
+
+func mapFromDataToSamlAudienceProtocolMapper(data *schema.ResourceData) *keycloak.SamlAudienceProtocolMapper {
+	return &keycloak.SamlAudienceProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		IncludedClientAudience: data.Get("included_client_audience").(string),
+		IncludedCustomAudience: data.Get("included_custom_audience").(string),
+	}
+}
+
+func mapFromSamlAudienceProtocolMapperToData(mapper *keycloak.SamlAudienceProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("included_client_audience", mapper.IncludedClientAudience)
+	data.Set("included_custom_audience", mapper.IncludedCustomAudience)
+}
+
+func resourceKeycloakSamlAudienceProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlAudienceMapper := mapFromDataToSamlAudienceProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlAudienceProtocolMapper(ctx, samlAudienceMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewSamlAudienceProtocolMapper(ctx, samlAudienceMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromSamlAudienceProtocolMapperToData(samlAudienceMapper, data)
+
+	return resourceKeycloakSamlAudienceProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlAudienceProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	samlAudienceMapper, err := keycloakClient.GetSamlAudienceProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromSamlAudienceProtocolMapperToData(samlAudienceMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakSamlAudienceProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlAudienceMapper := mapFromDataToSamlAudienceProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlAudienceProtocolMapper(ctx, samlAudienceMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateSamlAudienceProtocolMapper(ctx, samlAudienceMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakSamlAudienceProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlAudienceProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteSamlAudienceProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
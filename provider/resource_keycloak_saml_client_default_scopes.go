@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
@@ -15,6 +16,9 @@ func resourceKeycloakSamlClientDefaultScopes() *schema.Resource {
 		ReadContext:   resourceKeycloakSamlClientDefaultScopesRead,
 		DeleteContext: resourceKeycloakSamlClientDefaultScopesDelete,
 		UpdateContext: resourceKeycloakSamlClientDefaultScopesUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakSamlClientDefaultScopesImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"realm_id": {
 				Type:     schema.TypeString,
@@ -153,3 +157,16 @@ func resourceKeycloakSamlClientDefaultScopesDelete(ctx context.Context, data *sc
 
 	return diag.FromErr(keycloakClient.DetachSamlClientDefaultScopes(ctx, realmId, clientId, interfaceSliceToStringSlice(defaultScopes.List())))
 }
+
+func resourceKeycloakSamlClientDefaultScopesImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{clientId}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("client_id", parts[1])
+	d.SetId(samlClientDefaultScopesId(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"dario.cat/mergo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
@@ -44,6 +45,16 @@ func resourceKeycloakSamlClientScope() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"import": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"keep_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -92,9 +103,30 @@ func resourceKeycloakSamlClientScopeCreate(ctx context.Context, data *schema.Res
 
 	clientScope := getSamlClientScopeFromData(data)
 
-	err := keycloakClient.NewSamlClientScope(ctx, clientScope)
-	if err != nil {
-		return diag.FromErr(err)
+	if !data.Get("import").(bool) {
+		err := keycloakClient.NewSamlClientScope(ctx, clientScope)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		existingClientScopeId, err := keycloakClient.GetClientScopeIdByName(ctx, clientScope.RealmId, clientScope.Name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		existingClientScope, err := keycloakClient.GetSamlClientScope(ctx, clientScope.RealmId, existingClientScopeId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = mergo.Merge(clientScope, existingClientScope); err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = keycloakClient.UpdateSamlClientScope(ctx, clientScope)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	setSamlClientScopeData(data, clientScope)
@@ -115,6 +147,10 @@ func resourceKeycloakSamlClientScopeRead(ctx context.Context, data *schema.Resou
 
 	setSamlClientScopeData(data, clientScope)
 
+	if _, ok := data.GetOk("import"); !ok {
+		data.Set("import", false)
+	}
+
 	return nil
 }
 
@@ -134,6 +170,10 @@ func resourceKeycloakSamlClientScopeUpdate(ctx context.Context, data *schema.Res
 }
 
 func resourceKeycloakSamlClientScopeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if keepOnDestroy(data.GetRawState()) {
+		return nil
+	}
+
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
 	realmId := data.Get("realm_id").(string)
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_group_membership_protocol_mapper.saml_group_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_attribute_name", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_group_membership_protocol_mapper.saml_group_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_clientScope(clientScopeName, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_attribute_name", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_group_membership_protocol_mapper.saml_group_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+	updatedValue := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_group_membership_protocol_mapper.saml_group_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_attribute_name", value),
+				),
+			},
+			{
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_client(clientId, mapperName, updatedValue),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_attribute_name", updatedValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.SamlGroupMembershipProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_group_membership_protocol_mapper.saml_group_membership_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakSamlGroupMembershipProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteSamlGroupMembershipProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakSamlGroupMembershipProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlGroupMembershipProtocolMapper_validateSamlAttributeNameFormat(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	invalidSamlNameFormat := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlGroupMembershipProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakSamlGroupMembershipProtocolMapper_samlAttributeNameFormat(clientId, mapperName, invalidSamlNameFormat),
+				ExpectError: regexp.MustCompile("expected saml_attribute_name_format to be one of .+ got " + invalidSamlNameFormat),
+			},
+		},
+	})
+}
+
+func testAccKeycloakSamlGroupMembershipProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_saml_group_membership_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getSamlGroupMembershipMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("saml group membership protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getSamlGroupMembershipMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapperFetch(resourceName string, mapper *keycloak.SamlGroupMembershipProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getSamlGroupMembershipMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getSamlGroupMembershipMapperUsingState(state *terraform.State, resourceName string) (*keycloak.SamlGroupMembershipProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetSamlGroupMembershipProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapper_client(clientId, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "saml_group_membership_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	group_attribute_name       = "%s"
+	saml_attribute_name_format = "Unspecified"
+	full_path                  = false
+}`, testAccRealm.Realm, clientId, mapperName, value)
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapper_clientScope(clientScopeName, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client_scope" "saml_client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "saml_group_membership_mapper" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.saml_client_scope.id
+
+	group_attribute_name       = "%s"
+	saml_attribute_name_format = "Unspecified"
+	full_path                  = false
+}`, testAccRealm.Realm, clientScopeName, mapperName, value)
+}
+
+func testKeycloakSamlGroupMembershipProtocolMapper_samlAttributeNameFormat(clientId, mapperName, samlAttributeNameFormat string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_group_membership_protocol_mapper" "saml_group_membership_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	group_attribute_name       = "member"
+	saml_attribute_name_format = "%s"
+}`, testAccRealm.Realm, clientId, mapperName, samlAttributeNameFormat)
+}
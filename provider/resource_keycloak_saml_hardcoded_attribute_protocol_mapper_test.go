@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_hardcoded_attribute_protocol_mapper.saml_hardcoded_attribute_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "saml_attribute_value", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_hardcoded_attribute_protocol_mapper.saml_hardcoded_attribute_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_clientScope(clientScopeName, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "saml_attribute_value", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_hardcoded_attribute_protocol_mapper.saml_hardcoded_attribute_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+	updatedValue := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_hardcoded_attribute_protocol_mapper.saml_hardcoded_attribute_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_client(clientId, mapperName, value),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "saml_attribute_value", value),
+				),
+			},
+			{
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_client(clientId, mapperName, updatedValue),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "saml_attribute_value", updatedValue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedAttributeProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.SamlHardcodedAttributeProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	value := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_hardcoded_attribute_protocol_mapper.saml_hardcoded_attribute_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakSamlHardcodedAttributeProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteSamlHardcodedAttributeProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakSamlHardcodedAttributeProtocolMapper_client(clientId, mapperName, value),
+				Check:  testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+func testAccKeycloakSamlHardcodedAttributeProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_saml_hardcoded_attribute_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getSamlHardcodedAttributeMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("saml hardcoded attribute protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlHardcodedAttributeProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getSamlHardcodedAttributeMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlHardcodedAttributeProtocolMapperFetch(resourceName string, mapper *keycloak.SamlHardcodedAttributeProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getSamlHardcodedAttributeMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getSamlHardcodedAttributeMapperUsingState(state *terraform.State, resourceName string) (*keycloak.SamlHardcodedAttributeProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetSamlHardcodedAttributeProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakSamlHardcodedAttributeProtocolMapper_client(clientId, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_saml_hardcoded_attribute_protocol_mapper" "saml_hardcoded_attribute_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	saml_attribute_name  = "department"
+	saml_attribute_value = "%s"
+	friendly_name        = "Department"
+}`, testAccRealm.Realm, clientId, mapperName, value)
+}
+
+func testKeycloakSamlHardcodedAttributeProtocolMapper_clientScope(clientScopeName, mapperName, value string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client_scope" "saml_client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_saml_hardcoded_attribute_protocol_mapper" "saml_hardcoded_attribute_mapper" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.saml_client_scope.id
+
+	saml_attribute_name  = "department"
+	saml_attribute_value = "%s"
+	friendly_name        = "Department"
+}`, testAccRealm.Realm, clientScopeName, mapperName, value)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakSamlHardcodedRoleProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakSamlHardcodedRoleProtocolMapperCreate,
+		ReadContext:   resourceKeycloakSamlHardcodedRoleProtocolMapperRead,
+		UpdateContext: resourceKeycloakSamlHardcodedRoleProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakSamlHardcodedRoleProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_id"},
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func mapFromDataToSamlHardcodedRoleProtocolMapper(data *schema.ResourceData) *keycloak.SamlHardcodedRoleProtocolMapper {
+	return &keycloak.SamlHardcodedRoleProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		RoleId: data.Get("role_id").(string),
+	}
+}
+
+func mapFromSamlHardcodedRoleProtocolMapperToData(mapper *keycloak.SamlHardcodedRoleProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("role_id", mapper.RoleId)
+}
+
+func resourceKeycloakSamlHardcodedRoleProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlHardcodedRoleMapper := mapFromDataToSamlHardcodedRoleProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlHardcodedRoleProtocolMapper(ctx, samlHardcodedRoleMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewSamlHardcodedRoleProtocolMapper(ctx, samlHardcodedRoleMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromSamlHardcodedRoleProtocolMapperToData(samlHardcodedRoleMapper, data)
+
+	return resourceKeycloakSamlHardcodedRoleProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlHardcodedRoleProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	samlHardcodedRoleMapper, err := keycloakClient.GetSamlHardcodedRoleProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromSamlHardcodedRoleProtocolMapperToData(samlHardcodedRoleMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakSamlHardcodedRoleProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlHardcodedRoleMapper := mapFromDataToSamlHardcodedRoleProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlHardcodedRoleProtocolMapper(ctx, samlHardcodedRoleMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateSamlHardcodedRoleProtocolMapper(ctx, samlHardcodedRoleMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakSamlHardcodedRoleProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlHardcodedRoleProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteSamlHardcodedRoleProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
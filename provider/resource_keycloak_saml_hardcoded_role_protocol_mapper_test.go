@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakSamlHardcodedRoleProtocolMapper_basicClient(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	role := "role_one"
+
+	resourceName := "keycloak_saml_hardcoded_role_protocol_mapper.saml_hardcoded_role_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedRoleProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedRoleProtocolMapper_client(clientId, mapperName, role),
+				Check:  testKeycloakSamlHardcodedRoleProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedRoleProtocolMapper_basicClientScope(t *testing.T) {
+	t.Parallel()
+	clientScopeName := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	role := "role_one"
+
+	resourceName := "keycloak_saml_hardcoded_role_protocol_mapper.saml_hardcoded_role_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedRoleProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedRoleProtocolMapper_clientScope(clientScopeName, mapperName, role),
+				Check:  testKeycloakSamlHardcodedRoleProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedRoleProtocolMapper_import(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	role := "role_one"
+
+	resourceName := "keycloak_saml_hardcoded_role_protocol_mapper.saml_hardcoded_role_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedRoleProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedRoleProtocolMapper_client(clientId, mapperName, role),
+				Check:  testKeycloakSamlHardcodedRoleProtocolMapperExists(resourceName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedRoleProtocolMapper_update(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	role := "role_one"
+	updatedRole := "role_two"
+
+	resourceName := "keycloak_saml_hardcoded_role_protocol_mapper.saml_hardcoded_role_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedRoleProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedRoleProtocolMapper_client(clientId, mapperName, role),
+				Check:  testKeycloakSamlHardcodedRoleProtocolMapperExists(resourceName),
+			},
+			{
+				Config: testKeycloakSamlHardcodedRoleProtocolMapper_client(clientId, mapperName, updatedRole),
+				Check:  testKeycloakSamlHardcodedRoleProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedRoleProtocolMapper_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+	var mapper = &keycloak.SamlHardcodedRoleProtocolMapper{}
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	role := "role_one"
+
+	resourceName := "keycloak_saml_hardcoded_role_protocol_mapper.saml_hardcoded_role_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedRoleProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedRoleProtocolMapper_client(clientId, mapperName, role),
+				Check:  testKeycloakSamlHardcodedRoleProtocolMapperFetch(resourceName, mapper),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteSamlHardcodedRoleProtocolMapper(testCtx, mapper.RealmId, mapper.ClientId, mapper.ClientScopeId, mapper.Id)
+					if err != nil {
+						t.Error(err)
+					}
+				},
+				Config: testKeycloakSamlHardcodedRoleProtocolMapper_client(clientId, mapperName, role),
+				Check:  testKeycloakSamlHardcodedRoleProtocolMapperExists(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlHardcodedRoleProtocolMapper_clientRole(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resourceName := "keycloak_saml_hardcoded_role_protocol_mapper.saml_hardcoded_role_mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccKeycloakSamlHardcodedRoleProtocolMapperDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlHardcodedRoleProtocolMapper_clientRole(clientId, mapperName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testKeycloakSamlHardcodedRoleProtocolMapperExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_id", "keycloak_role.client_role", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGenericProtocolMapperIdForClient(resourceName),
+			},
+		},
+	})
+}
+
+func testAccKeycloakSamlHardcodedRoleProtocolMapperDestroy() resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for resourceName, rs := range state.RootModule().Resources {
+			if rs.Type != "keycloak_saml_hardcoded_role_protocol_mapper" {
+				continue
+			}
+
+			mapper, _ := getSamlHardcodedRoleMapperUsingState(state, resourceName)
+
+			if mapper != nil {
+				return fmt.Errorf("saml hardcoded role protocol mapper with id %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlHardcodedRoleProtocolMapperExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := getSamlHardcodedRoleMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakSamlHardcodedRoleProtocolMapperFetch(resourceName string, mapper *keycloak.SamlHardcodedRoleProtocolMapper) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		fetchedMapper, err := getSamlHardcodedRoleMapperUsingState(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		mapper.Id = fetchedMapper.Id
+		mapper.ClientId = fetchedMapper.ClientId
+		mapper.ClientScopeId = fetchedMapper.ClientScopeId
+		mapper.RealmId = fetchedMapper.RealmId
+
+		return nil
+	}
+}
+
+func getSamlHardcodedRoleMapperUsingState(state *terraform.State, resourceName string) (*keycloak.SamlHardcodedRoleProtocolMapper, error) {
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found in TF state: %s ", resourceName)
+	}
+
+	id := rs.Primary.ID
+	realm := rs.Primary.Attributes["realm_id"]
+	clientId := rs.Primary.Attributes["client_id"]
+	clientScopeId := rs.Primary.Attributes["client_scope_id"]
+
+	return keycloakClient.GetSamlHardcodedRoleProtocolMapper(testCtx, realm, clientId, clientScopeId, id)
+}
+
+func testKeycloakSamlHardcodedRoleProtocolMapper_client(clientId, mapperName, role string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_role" "role_one" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-one"
+}
+
+resource "keycloak_role" "role_two" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-two"
+}
+
+resource "keycloak_saml_hardcoded_role_protocol_mapper" "saml_hardcoded_role_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	role_id = keycloak_role.%s.id
+}`, testAccRealm.Realm, clientId, mapperName, mapperName, mapperName, role)
+}
+
+func testKeycloakSamlHardcodedRoleProtocolMapper_clientScope(clientScopeName, mapperName, role string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client_scope" "saml_client_scope" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_role" "role_one" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-one"
+}
+
+resource "keycloak_role" "role_two" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s-two"
+}
+
+resource "keycloak_saml_hardcoded_role_protocol_mapper" "saml_hardcoded_role_mapper" {
+	name            = "%s"
+	realm_id        = data.keycloak_realm.realm.id
+	client_scope_id = keycloak_saml_client_scope.saml_client_scope.id
+
+	role_id = keycloak_role.%s.id
+}`, testAccRealm.Realm, clientScopeName, mapperName, mapperName, mapperName, role)
+}
+
+func testKeycloakSamlHardcodedRoleProtocolMapper_clientRole(clientId, mapperName, roleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_client" "saml_client" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = "%s"
+}
+
+resource "keycloak_role" "client_role" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+	name      = "%s"
+}
+
+resource "keycloak_saml_hardcoded_role_protocol_mapper" "saml_hardcoded_role_mapper" {
+	name      = "%s"
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_saml_client.saml_client.id
+
+	role_id = keycloak_role.client_role.id
+}`, testAccRealm.Realm, clientId, roleName, mapperName)
+}
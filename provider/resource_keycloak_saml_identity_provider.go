@@ -47,6 +47,16 @@ var authnComparisonTypes = []string{
 	"better",
 }
 
+// samlMetadata lists the attributes imported from the SAML metadata of the identity provider
+var samlMetadata = &identityProviderMetadata{
+	attributes: map[string]string{
+		"single_sign_on_service_url": "singleSignOnServiceUrl",
+		"single_logout_service_url":  "singleLogoutServiceUrl",
+		"signing_certificate":        "signingCertificate",
+	},
+	required: []string{"single_sign_on_service_url"},
+}
+
 func resourceKeycloakSamlIdentityProvider() *schema.Resource {
 	samlSchema := map[string]*schema.Schema{
 		"provider_id": {
@@ -183,6 +193,7 @@ func resourceKeycloakSamlIdentityProvider() *schema.Resource {
 	samlResource.CreateContext = resourceKeycloakIdentityProviderCreate(getSamlIdentityProviderFromData, setSamlIdentityProviderData)
 	samlResource.ReadContext = resourceKeycloakIdentityProviderRead(setSamlIdentityProviderData)
 	samlResource.UpdateContext = resourceKeycloakIdentityProviderUpdate(getSamlIdentityProviderFromData, setSamlIdentityProviderData)
+	samlMetadata.addMetadataUrl(samlResource)
 	return samlResource
 }
 
@@ -235,6 +246,8 @@ func getSamlIdentityProviderFromData(data *schema.ResourceData, keycloakVersion
 		return nil, err
 	}
 
+	getMetadataUrlFromData(data, samlIdentityProviderConfig)
+
 	rec.Config = samlIdentityProviderConfig
 
 	return rec, nil
@@ -272,6 +285,7 @@ func setSamlIdentityProviderData(data *schema.ResourceData, identityProvider *ke
 	data.Set("authn_context_class_refs", identityProvider.Config.AuthnContextClassRefs)
 	data.Set("authn_context_comparison_type", identityProvider.Config.AuthnContextComparisonType)
 	data.Set("authn_context_decl_refs", identityProvider.Config.AuthnContextDeclRefs)
+	setMetadataUrlData(data, identityProvider.Config)
 
 	if keycloakVersion.LessThan(keycloak.Version_26.AsVersion()) {
 		// Since keycloak v26 the attribute "hideOnLoginPage" is not part of the identity provider config anymore!
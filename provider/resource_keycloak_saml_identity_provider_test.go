@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"strconv"
 	"testing"
@@ -260,6 +261,42 @@ func TestAccKeycloakSamlIdentityProvider_basicUpdateAll(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakSamlIdentityProvider_metadataUrl(t *testing.T) {
+	t.Parallel()
+
+	samlName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSamlIdentityProviderDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlIdentityProvider_metadataUrl(samlName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakSamlIdentityProviderExists("keycloak_saml_identity_provider.saml"),
+					resource.TestMatchResourceAttr("keycloak_saml_identity_provider.saml", "single_sign_on_service_url", regexp.MustCompile(regexp.QuoteMeta("/realms/"+testAccRealm.Realm+"/protocol/saml")+"$")),
+					resource.TestCheckResourceAttrSet("keycloak_saml_identity_provider.saml", "signing_certificate"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_saml_identity_provider.saml",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testAccRealm.Realm + "/" + samlName,
+			},
+			{
+				Config: testKeycloakSamlIdentityProvider_basic(samlName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_saml_identity_provider.saml", "metadata_url", ""),
+					resource.TestCheckResourceAttr("keycloak_saml_identity_provider.saml", "single_sign_on_service_url", "https://example.com/auth"),
+					resource.TestCheckResourceAttr("keycloak_saml_identity_provider.saml", "signing_certificate", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakSamlIdentityProviderExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getKeycloakSamlIdentityProviderFromState(s, resourceName)
@@ -509,3 +546,18 @@ resource "keycloak_saml_identity_provider" "saml" {
 }
 	`, testAccRealm.Realm, organizationName, saml)
 }
+
+func testKeycloakSamlIdentityProvider_metadataUrl(saml string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm        = data.keycloak_realm.realm.id
+	alias        = "%s"
+	entity_id    = "https://example.com/entity_id"
+	metadata_url = "%s/realms/%s/protocol/saml/descriptor"
+}
+	`, testAccRealm.Realm, saml, os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakSamlRoleListProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakSamlRoleListProtocolMapperCreate,
+		ReadContext:   resourceKeycloakSamlRoleListProtocolMapperRead,
+		UpdateContext: resourceKeycloakSamlRoleListProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakSamlRoleListProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_id"},
+			},
+			"role_attribute_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Role",
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"saml_attribute_name_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Basic",
+				ValidateFunc: validation.StringInSlice(keycloakSamlUserAttributeProtocolMapperNameFormats, false),
+			},
+			"single_role_attribute": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func mapFromDataToSamlRoleListProtocolMapper(data *schema.ResourceData) *keycloak.SamlRoleListProtocolMapper {
+	return &keycloak.SamlRoleListProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		RoleAttributeName:       data.Get("role_attribute_name").(string),
+		FriendlyName:            data.Get("friendly_name").(string),
+		SamlAttributeNameFormat: data.Get("saml_attribute_name_format").(string),
+		SingleRoleAttribute:     data.Get("single_role_attribute").(bool),
+	}
+}
+
+func mapFromSamlRoleListProtocolMapperToData(mapper *keycloak.SamlRoleListProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("role_attribute_name", mapper.RoleAttributeName)
+	data.Set("friendly_name", mapper.FriendlyName)
+	data.Set("saml_attribute_name_format", mapper.SamlAttributeNameFormat)
+	data.Set("single_role_attribute", mapper.SingleRoleAttribute)
+}
+
+func resourceKeycloakSamlRoleListProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlRoleListMapper := mapFromDataToSamlRoleListProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlRoleListProtocolMapper(ctx, samlRoleListMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewSamlRoleListProtocolMapper(ctx, samlRoleListMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromSamlRoleListProtocolMapperToData(samlRoleListMapper, data)
+
+	return resourceKeycloakSamlRoleListProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlRoleListProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	samlRoleListMapper, err := keycloakClient.GetSamlRoleListProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromSamlRoleListProtocolMapperToData(samlRoleListMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakSamlRoleListProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlRoleListMapper := mapFromDataToSamlRoleListProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlRoleListProtocolMapper(ctx, samlRoleListMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateSamlRoleListProtocolMapper(ctx, samlRoleListMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakSamlRoleListProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlRoleListProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteSamlRoleListProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
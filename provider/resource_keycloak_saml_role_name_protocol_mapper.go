@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakSamlRoleNameProtocolMapper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakSamlRoleNameProtocolMapperCreate,
+		ReadContext:   resourceKeycloakSamlRoleNameProtocolMapperRead,
+		UpdateContext: resourceKeycloakSamlRoleNameProtocolMapperUpdate,
+		DeleteContext: resourceKeycloakSamlRoleNameProtocolMapperDelete,
+		Importer: &schema.ResourceImporter{
+			// import a mapper tied to a client:
+			// {{realmId}}/client/{{clientId}}/{{protocolMapperId}}
+			// or a client scope:
+			// {{realmId}}/client-scope/{{clientScopeId}}/{{protocolMapperId}}
+			StateContext: genericProtocolMapperImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_scope_id"},
+			},
+			"client_scope_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"client_id"},
+			},
+			"role_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"new_role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func mapFromDataToSamlRoleNameProtocolMapper(data *schema.ResourceData) *keycloak.SamlRoleNameProtocolMapper {
+	return &keycloak.SamlRoleNameProtocolMapper{
+		Id:            data.Id(),
+		Name:          data.Get("name").(string),
+		RealmId:       data.Get("realm_id").(string),
+		ClientId:      data.Get("client_id").(string),
+		ClientScopeId: data.Get("client_scope_id").(string),
+
+		RoleId:      data.Get("role_id").(string),
+		NewRoleName: data.Get("new_role_name").(string),
+	}
+}
+
+func mapFromSamlRoleNameProtocolMapperToData(mapper *keycloak.SamlRoleNameProtocolMapper, data *schema.ResourceData) {
+	data.SetId(mapper.Id)
+	data.Set("name", mapper.Name)
+	data.Set("realm_id", mapper.RealmId)
+
+	if mapper.ClientId != "" {
+		data.Set("client_id", mapper.ClientId)
+	} else {
+		data.Set("client_scope_id", mapper.ClientScopeId)
+	}
+
+	data.Set("role_id", mapper.RoleId)
+	data.Set("new_role_name", mapper.NewRoleName)
+}
+
+func resourceKeycloakSamlRoleNameProtocolMapperCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlRoleNameMapper := mapFromDataToSamlRoleNameProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlRoleNameProtocolMapper(ctx, samlRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.NewSamlRoleNameProtocolMapper(ctx, samlRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapFromSamlRoleNameProtocolMapperToData(samlRoleNameMapper, data)
+
+	return resourceKeycloakSamlRoleNameProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlRoleNameProtocolMapperRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	samlRoleNameMapper, err := keycloakClient.GetSamlRoleNameProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	mapFromSamlRoleNameProtocolMapperToData(samlRoleNameMapper, data)
+
+	return nil
+}
+
+func resourceKeycloakSamlRoleNameProtocolMapperUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	samlRoleNameMapper := mapFromDataToSamlRoleNameProtocolMapper(data)
+
+	err := keycloakClient.ValidateSamlRoleNameProtocolMapper(ctx, samlRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateSamlRoleNameProtocolMapper(ctx, samlRoleNameMapper)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakSamlRoleNameProtocolMapperRead(ctx, data, meta)
+}
+
+func resourceKeycloakSamlRoleNameProtocolMapperDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+	clientScopeId := data.Get("client_scope_id").(string)
+
+	return diag.FromErr(keycloakClient.DeleteSamlRoleNameProtocolMapper(ctx, realmId, clientId, clientScopeId, data.Id()))
+}
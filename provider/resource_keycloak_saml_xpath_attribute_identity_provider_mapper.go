@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+const samlXpathAttributeIdentityProviderMapperXpathKey = "attribute.xpath"
+
+func resourceKeycloakSamlXpathAttributeIdentityProviderMapper() *schema.Resource {
+	mapperSchema := map[string]*schema.Schema{
+		"attribute_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Attribute Name",
+			ExactlyOneOf: []string{"attribute_name", "attribute_friendly_name"},
+		},
+		"attribute_friendly_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Attribute Friendly Name",
+		},
+		"attribute_xpath": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "XPath expression evaluated on the value of the attribute",
+		},
+		"user_attribute": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "User Attribute",
+		},
+		"sync_mode":    identityProviderMapperSyncModeSchema(),
+		"extra_config": identityProviderMapperExtraConfigSchema(samlXpathAttributeIdentityProviderMapperXpathKey),
+	}
+	genericMapperResource := resourceKeycloakIdentityProviderMapper()
+	genericMapperResource.Schema = mergeSchemas(genericMapperResource.Schema, mapperSchema)
+	genericMapperResource.CreateContext = resourceKeycloakIdentityProviderMapperCreate(getSamlXpathAttributeIdentityProviderMapperFromData, setSamlXpathAttributeIdentityProviderMapperData)
+	genericMapperResource.ReadContext = resourceKeycloakIdentityProviderMapperRead(setSamlXpathAttributeIdentityProviderMapperData)
+	genericMapperResource.UpdateContext = resourceKeycloakIdentityProviderMapperUpdate(getSamlXpathAttributeIdentityProviderMapperFromData, setSamlXpathAttributeIdentityProviderMapperData)
+	return genericMapperResource
+}
+
+func getSamlXpathAttributeIdentityProviderMapperFromData(ctx context.Context, data *schema.ResourceData, meta interface{}) (*keycloak.IdentityProviderMapper, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	rec, _ := getIdentityProviderMapperFromData(data)
+	_, err := getIdentityProviderMapperIdentityProvider(ctx, keycloakClient, "keycloak_saml_xpath_attribute_identity_provider_mapper", rec, isSamlIdentityProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.IdentityProviderMapper = "saml-xpath-attribute-idp-mapper"
+	rec.Config.Attribute = data.Get("attribute_name").(string)
+	rec.Config.AttributeFriendlyName = data.Get("attribute_friendly_name").(string)
+	rec.Config.UserAttribute = data.Get("user_attribute").(string)
+	rec.Config.ExtraConfig[samlXpathAttributeIdentityProviderMapperXpathKey] = data.Get("attribute_xpath").(string)
+	getIdentityProviderMapperSyncModeFromData(data, rec)
+
+	return rec, nil
+}
+
+func setSamlXpathAttributeIdentityProviderMapperData(data *schema.ResourceData, identityProviderMapper *keycloak.IdentityProviderMapper) error {
+	attributeXpath, _ := identityProviderMapper.Config.ExtraConfig[samlXpathAttributeIdentityProviderMapperXpathKey].(string)
+
+	setIdentityProviderMapperData(data, identityProviderMapper)
+	setIdentityProviderMapperSyncModeData(data, identityProviderMapper)
+	data.Set("attribute_name", identityProviderMapper.Config.Attribute)
+	data.Set("attribute_friendly_name", identityProviderMapper.Config.AttributeFriendlyName)
+	data.Set("attribute_xpath", attributeXpath)
+	data.Set("user_attribute", identityProviderMapper.Config.UserAttribute)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeycloakSamlXpathAttributeIdentityProviderMapper_basic(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_saml_xpath_attribute_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_saml_xpath_attribute_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlXpathAttributeIdentityProviderMapper_basic(alias, mapperName, "//*[local-name()='Street']", "street"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakIdentityProviderMapperExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute_name", "address"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_xpath", "//*[local-name()='Street']"),
+					resource.TestCheckResourceAttr(resourceName, "user_attribute", "street"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getIdentityProviderMapperImportId(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakSamlXpathAttributeIdentityProviderMapper_update(t *testing.T) {
+	t.Parallel()
+
+	alias := acctest.RandomWithPrefix("tf-acc")
+	mapperName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_saml_xpath_attribute_identity_provider_mapper.mapper"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakIdentityProviderMapperDestroy("keycloak_saml_xpath_attribute_identity_provider_mapper"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSamlXpathAttributeIdentityProviderMapper_basic(alias, mapperName, "//*[local-name()='Street']", "street"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "user_attribute", "street"),
+			},
+			{
+				Config: testKeycloakSamlXpathAttributeIdentityProviderMapper_basic(alias, mapperName, "//*[local-name()='City']", "city"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attribute_xpath", "//*[local-name()='City']"),
+					resource.TestCheckResourceAttr(resourceName, "user_attribute", "city"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakSamlXpathAttributeIdentityProviderMapper_basic(alias, name, attributeXpath, userAttribute string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_saml_identity_provider" "saml" {
+	realm                      = data.keycloak_realm.realm.id
+	alias                      = "%s"
+	entity_id                  = "https://example.com/entity_id"
+	single_sign_on_service_url = "https://example.com/auth"
+}
+
+resource "keycloak_saml_xpath_attribute_identity_provider_mapper" "mapper" {
+	realm                   = data.keycloak_realm.realm.id
+	name                    = "%s"
+	identity_provider_alias = keycloak_saml_identity_provider.saml.alias
+	attribute_name          = "address"
+	attribute_xpath         = "%s"
+	user_attribute          = "%s"
+	sync_mode               = "FORCE"
+}
+	`, testAccRealm.Realm, alias, name, attributeXpath, userAttribute)
+}
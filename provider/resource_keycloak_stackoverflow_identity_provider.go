@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakStackoverflowIdentityProvider() *schema.Resource {
+	return resourceKeycloakSocialIdentityProvider(&socialIdentityProvider{
+		providerId: "stackoverflow",
+		schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The key of the Stack Apps application, sent along with the API requests.",
+			},
+		},
+		getConfig: func(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+			config.Key = data.Get("key").(string)
+		},
+		setData: func(data *schema.ResourceData, config *keycloak.IdentityProviderConfig) {
+			data.Set("key", config.Key)
+		},
+	})
+}
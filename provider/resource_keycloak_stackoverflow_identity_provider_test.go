@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKeycloakStackoverflowIdentityProvider_key(t *testing.T) {
+	alias := acctest.RandomWithPrefix("tf-acc")
+	key := acctest.RandString(24)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckKeycloakSocialIdentityProviderDestroy("keycloak_stackoverflow_identity_provider"),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakSocialIdentityProvider_basic("keycloak_stackoverflow_identity_provider", alias, `key = "`+key+`"`),
+				Check:  testAccCheckKeycloakStackoverflowIdentityProviderHasKey("keycloak_stackoverflow_identity_provider.social", key),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakStackoverflowIdentityProviderHasKey(resourceName, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		idp, err := getKeycloakOidcGoogleIdentityProviderFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if idp.Config.Key != key {
+			return fmt.Errorf("expected identity provider %s to have key %s, got %s", idp.Alias, key, idp.Config.Key)
+		}
+
+		return nil
+	}
+}
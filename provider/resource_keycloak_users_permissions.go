@@ -47,8 +47,13 @@ func resourceKeycloakUsersPermissionsReconcile(ctx context.Context, data *schema
 
 	realmId := data.Get("realm_id").(string)
 
+	err := checkAdminPermissionsV1(ctx, keycloakClient, realmId, "Users")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// the existence of this resource implies that it is enabled.
-	err := keycloakClient.EnableUsersPermissions(ctx, realmId)
+	err = keycloakClient.EnableUsersPermissions(ctx, realmId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -38,6 +39,32 @@ func TestAccKeycloakUsersPermission_basic(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUsersPermission_adminPermissionsV2(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_26_2)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		PreCheck:          func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm                     = "%s"
+	admin_permissions_enabled = true
+}
+
+resource "keycloak_users_permissions" "my_permission" {
+	realm_id = keycloak_realm.realm.id
+}
+	`, realmName),
+				ExpectError: regexp.MustCompile("must be managed with keycloak_admin_permission"),
+			},
+		},
+	})
+}
+
 func testAccCheckKeycloakUsersPermissionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		permissions, err := getUsersPermissionsFromState(s, resourceName)
@@ -119,6 +119,10 @@ func intPointer(i int) *int {
 	return &i
 }
 
+func boolPointer(b bool) *bool {
+	return &b
+}
+
 // requiredWithoutAll returns a validator which checks that the attribute at `argument` exists
 // if none of the attributes in `checkExists` exist in the configuration
 func requiredWithoutAll(key cty.Path, checkExists []cty.Path) schema.ValidateRawResourceConfigFunc {